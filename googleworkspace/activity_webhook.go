@@ -0,0 +1,363 @@
+package googleworkspace
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	admin "google.golang.org/api/admin/reports/v1"
+)
+
+const (
+	// The default maximum number of notifications buffered by the receiver
+	defaultActivityWebhookBufferSize = 10000
+
+	// The maximum size of a notification body accepted by the receiver
+	activityWebhookMaxBodySize = 1 << 20
+)
+
+// activityChannel is a push notification channel created by the plugin. The channel is a named field, since the
+// MarshalJSON method of an embedded channel would replace the encoding of the whole struct in the persisted state.
+type activityChannel struct {
+	Channel         admin.Channel
+	ApplicationName string
+	UserKey         string
+	EventName       string
+	CreatedTime     time.Time
+	Status          string
+	ListenAddress   string
+}
+
+// isActive returns true if the channel has neither been stopped nor expired
+func (c *activityChannel) isActive() bool {
+	return c.Status == "active" && (c.Channel.Expiration == 0 || time.UnixMilli(c.Channel.Expiration).After(time.Now()))
+}
+
+// activityNotification is an activity delivered to the receiver through a push notification channel
+type activityNotification struct {
+	ChannelId     string
+	MessageNumber int64
+	ResourceState string
+	ReceivedTime  time.Time
+	Activity      *admin.Activity
+}
+
+// activityWebhookReceiver is a local HTTP server, which receives the push notifications of the activity channels
+// created by the plugin, and buffers the delivered activities in memory
+type activityWebhookReceiver struct {
+	mu            sync.Mutex
+	server        *http.Server
+	bufferSize    int
+	channels      map[string]*activityChannel
+	notifications []activityNotification
+}
+
+// Receivers are shared by all the connections of the plugin, keyed by their listen address
+var (
+	activityWebhookReceivers   = map[string]*activityWebhookReceiver{}
+	activityWebhookReceiversMu sync.Mutex
+
+	// The persisted channels are restored once, by the first query which needs a receiver
+	activityChannelsRestoreOnce sync.Once
+)
+
+// getActivityWebhookReceiver returns the receiver for the listen address configured in the connection,
+// starting it if it is not already running
+func getActivityWebhookReceiver(ctx context.Context, d *plugin.QueryData) (*activityWebhookReceiver, error) {
+	config := GetConfig(d.Connection)
+	if config.ActivityWebhookListenAddress == nil || *config.ActivityWebhookListenAddress == "" {
+		return nil, errors.New("activity_webhook_listen_address must be configured to receive activity notifications")
+	}
+
+	// Restore the persisted channels before any channel is saved, so that they are not overwritten
+	activityChannelsRestoreOnce.Do(func() {
+		restoreActivityChannels(ctx)
+	})
+
+	activityWebhookReceiversMu.Lock()
+	defer activityWebhookReceiversMu.Unlock()
+
+	return startActivityWebhookReceiver(ctx, *config.ActivityWebhookListenAddress, config.ActivityWebhookBufferSize)
+}
+
+// restoreActivityChannels starts the receivers of the channels persisted by a previous run of the plugin, which are
+// still active, so that their notifications are received again, and the channels can be listed and stopped
+func restoreActivityChannels(ctx context.Context) {
+	channels, err := loadActivityChannels()
+	if err != nil {
+		plugin.Logger(ctx).Warn("restoreActivityChannels", "state_path", activityWebhookStatePath(), "error", err)
+		return
+	}
+
+	activityWebhookReceiversMu.Lock()
+	defer activityWebhookReceiversMu.Unlock()
+
+	for _, channel := range channels {
+		if !channel.isActive() || channel.ListenAddress == "" {
+			continue
+		}
+		receiver, err := startActivityWebhookReceiver(ctx, channel.ListenAddress, nil)
+		if err != nil {
+			plugin.Logger(ctx).Warn("restoreActivityChannels", "listen_address", channel.ListenAddress, "error", err)
+			continue
+		}
+		receiver.mu.Lock()
+		receiver.channels[channel.Channel.Id] = channel
+		receiver.mu.Unlock()
+	}
+}
+
+// startActivityWebhookReceiver returns the receiver for the given listen address, starting it if it is not already
+// running. The receiver keeps running for the lifetime of the plugin. The caller must hold activityWebhookReceiversMu.
+func startActivityWebhookReceiver(ctx context.Context, listenAddress string, bufferSizeConfig *int) (*activityWebhookReceiver, error) {
+	bufferSize := defaultActivityWebhookBufferSize
+	if bufferSizeConfig != nil && *bufferSizeConfig > 0 {
+		bufferSize = *bufferSizeConfig
+	}
+
+	if receiver, ok := activityWebhookReceivers[listenAddress]; ok {
+		// The receiver may have been started before the connection config was available
+		if bufferSizeConfig != nil {
+			receiver.mu.Lock()
+			receiver.bufferSize = bufferSize
+			receiver.mu.Unlock()
+		}
+		return receiver, nil
+	}
+
+	receiver := &activityWebhookReceiver{
+		bufferSize: bufferSize,
+		channels:   map[string]*activityChannel{},
+	}
+	receiver.server = &http.Server{
+		Handler:           receiver,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, err
+	}
+	// The receiver is not bound to the context of the query which started it
+	logger := plugin.Logger(ctx)
+	go func() {
+		if err := receiver.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("activityWebhookReceiver.Serve", "listen_address", listenAddress, "error", err)
+		}
+	}()
+	logger.Info("activityWebhookReceiver", "message", "started receiving activity notifications", "listen_address", listenAddress)
+
+	activityWebhookReceivers[listenAddress] = receiver
+	return receiver, nil
+}
+
+// ServeHTTP handles the push notifications sent by the Reports API
+func (r *activityWebhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	channelID := req.Header.Get("X-Goog-Channel-ID")
+	token := req.Header.Get("X-Goog-Channel-Token")
+
+	// Only accept notifications of the channels created by the plugin, with a matching token
+	r.mu.Lock()
+	channel, ok := r.channels[channelID]
+	valid := ok && channel.Status == "active" && token != "" && subtle.ConstantTimeCompare([]byte(channel.Channel.Token), []byte(token)) == 1
+	r.mu.Unlock()
+	if !valid {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	notification := activityNotification{
+		ChannelId:     channelID,
+		ResourceState: req.Header.Get("X-Goog-Resource-State"),
+		ReceivedTime:  time.Now().UTC(),
+	}
+	notification.MessageNumber, _ = strconv.ParseInt(req.Header.Get("X-Goog-Message-Number"), 10, 64)
+
+	// The first notification of a channel is a sync message, which has no activity
+	if notification.ResourceState != "sync" {
+		body, err := io.ReadAll(io.LimitReader(req.Body, activityWebhookMaxBodySize))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		activity := &admin.Activity{}
+		if err := json.Unmarshal(body, activity); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		notification.Activity = activity
+	}
+
+	r.mu.Lock()
+	r.notifications = append(r.notifications, notification)
+	if len(r.notifications) > r.bufferSize {
+		r.notifications = r.notifications[len(r.notifications)-r.bufferSize:]
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// addChannel registers a channel, so that its notifications are accepted by the receiver
+func (r *activityWebhookReceiver) addChannel(ctx context.Context, channel *activityChannel) {
+	r.mu.Lock()
+	r.channels[channel.Channel.Id] = channel
+	r.mu.Unlock()
+
+	saveActivityChannels(ctx)
+}
+
+// updateChannel applies the given update to the channel under the lock of the receiver, persists the channels,
+// and returns a copy of the updated channel
+func (r *activityWebhookReceiver) updateChannel(ctx context.Context, channel *activityChannel, update func(*activityChannel)) activityChannel {
+	r.mu.Lock()
+	update(channel)
+	result := *channel
+	r.mu.Unlock()
+
+	saveActivityChannels(ctx)
+	return result
+}
+
+// getChannel returns a copy of the channel with the given ID, if it was created by the plugin
+func (r *activityWebhookReceiver) getChannel(id string) (activityChannel, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	channel, ok := r.channels[id]
+	if !ok {
+		return activityChannel{}, false
+	}
+	return *channel, true
+}
+
+// findActiveChannel returns a copy of the active channel watching the same activities as the given channel, if any
+func (r *activityWebhookReceiver) findActiveChannel(channel *activityChannel) (activityChannel, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range r.channels {
+		if c.isActive() && c.ApplicationName == channel.ApplicationName && c.UserKey == channel.UserKey && c.EventName == channel.EventName && c.Channel.Address == channel.Channel.Address {
+			return *c, true
+		}
+	}
+	return activityChannel{}, false
+}
+
+// listChannels returns a copy of the channels created by the plugin
+func (r *activityWebhookReceiver) listChannels() []activityChannel {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	channels := make([]activityChannel, 0, len(r.channels))
+	for _, channel := range r.channels {
+		c := *channel
+		if c.Status == "active" && !c.isActive() {
+			c.Status = "expired"
+		}
+		channels = append(channels, c)
+	}
+	return channels
+}
+
+// stopChannel marks the channel as stopped, so that its notifications are no longer accepted
+func (r *activityWebhookReceiver) stopChannel(ctx context.Context, id string) {
+	r.mu.Lock()
+	if channel, ok := r.channels[id]; ok {
+		channel.Status = "stopped"
+		channel.Channel.Token = ""
+	}
+	r.mu.Unlock()
+
+	saveActivityChannels(ctx)
+}
+
+// listNotifications returns a copy of the buffered notifications
+func (r *activityWebhookReceiver) listNotifications() []activityNotification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]activityNotification{}, r.notifications...)
+}
+
+// newActivityChannelSecret returns a random hex string, used for the ID and the token of a channel
+func newActivityChannelSecret() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// activityWebhookStatePath returns the path of the file where the active channels are persisted, so that the plugin
+// keeps accepting their notifications, and can stop them, after a restart
+func activityWebhookStatePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, pluginName, "activity_channels.json")
+}
+
+// loadActivityChannels returns the channels persisted by the plugin
+func loadActivityChannels() ([]*activityChannel, error) {
+	data, err := os.ReadFile(activityWebhookStatePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var channels []*activityChannel
+	if err := json.Unmarshal(data, &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// saveActivityChannels persists the active channels of all the receivers. Stopped and expired channels are dropped,
+// since no notification is accepted for them anymore.
+func saveActivityChannels(ctx context.Context) {
+	activityWebhookReceiversMu.Lock()
+	defer activityWebhookReceiversMu.Unlock()
+
+	channels := []*activityChannel{}
+	for _, receiver := range activityWebhookReceivers {
+		receiver.mu.Lock()
+		for _, channel := range receiver.channels {
+			if channel.isActive() {
+				c := *channel
+				channels = append(channels, &c)
+			}
+		}
+		receiver.mu.Unlock()
+	}
+
+	// The file holds the tokens of the channels, so it is only readable by the user
+	path := activityWebhookStatePath()
+	data, err := json.Marshal(channels)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0700)
+	}
+	if err == nil {
+		err = os.WriteFile(path, data, 0600)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Warn("saveActivityChannels", "state_path", path, "error", err)
+	}
+}
@@ -5,10 +5,16 @@ import (
 )
 
 type googleworkspaceConfig struct {
-	CredentialFile        *string `hcl:"credential_file"`
-	Credentials           *string `hcl:"credentials"`
-	ImpersonatedUserEmail *string `hcl:"impersonated_user_email"`
-	TokenPath             *string `hcl:"token_path"`
+	CredentialFile               *string  `hcl:"credential_file"`
+	Credentials                  *string  `hcl:"credentials"`
+	ImpersonatedUserEmail        *string  `hcl:"impersonated_user_email"`
+	TokenPath                    *string  `hcl:"token_path"`
+	ActivityApplicationNames     []string `hcl:"activity_application_names,optional"`
+	ActivityReportMaxConcurrency *int     `hcl:"activity_report_max_concurrency"`
+	ActivityWebhookAddress       *string  `hcl:"activity_webhook_address"`
+	ActivityWebhookListenAddress *string  `hcl:"activity_webhook_listen_address"`
+	ActivityWebhookBufferSize    *int     `hcl:"activity_webhook_buffer_size"`
+	DriveContentMaxSize          *int     `hcl:"drive_content_max_size"`
 }
 
 func ConfigInstance() interface{} {
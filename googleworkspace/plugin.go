@@ -27,12 +27,37 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 		},
 		TableMap: map[string]*plugin.Table{
+			"googleworkspace_activity_application":    tableGoogleworkspaceActivityApplication(ctx),
+			"googleworkspace_activity_channel":        tableGoogleworkspaceActivityChannel(ctx),
+			"googleworkspace_activity_notification":   tableGoogleworkspaceActivityNotification(ctx),
 			"googleworkspace_activity_report":         tableGoogleworkspaceActivityReport(ctx),
+			"googleworkspace_activity_report_event":   tableGoogleworkspaceActivityReportEvent(ctx),
+			"googleworkspace_building":                tableGoogleWorkspaceBuilding(ctx),
 			"googleworkspace_calendar":                tableGoogleWorkspaceCalendar(ctx),
+			"googleworkspace_calendar_acl":            tableGoogleWorkspaceCalendarAcl(ctx),
 			"googleworkspace_calendar_event":          tableGoogleWorkspaceCalendarEvent(ctx),
+			"googleworkspace_calendar_event_instance": tableGoogleWorkspaceCalendarEventInstance(ctx),
+			"googleworkspace_calendar_feature":        tableGoogleWorkspaceCalendarFeature(ctx),
+			"googleworkspace_calendar_freebusy":       tableGoogleWorkspaceCalendarFreeBusy(ctx),
+			"googleworkspace_calendar_list":           tableGoogleWorkspaceCalendarList(ctx),
 			"googleworkspace_calendar_my_event":       tableGoogleWorkspaceCalendarMyEvent(ctx),
+			"googleworkspace_calendar_resource":       tableGoogleWorkspaceCalendarResource(ctx),
+			"googleworkspace_calendar_team_schedule":  tableGoogleWorkspaceCalendarTeamSchedule(ctx),
 			"googleworkspace_drive":                   tableGoogleWorkspaceDrive(ctx),
+			"googleworkspace_drive_about":             tableGoogleWorkspaceDriveAbout(ctx),
+			"googleworkspace_drive_change":            tableGoogleWorkspaceDriveChange(ctx),
+			"googleworkspace_drive_comment":           tableGoogleWorkspaceDriveComment(ctx),
+			"googleworkspace_drive_comment_reply":     tableGoogleWorkspaceDriveCommentReply(ctx),
+			"googleworkspace_drive_external_share":    tableGoogleWorkspaceDriveExternalShare(ctx),
+			"googleworkspace_drive_file":              tableGoogleWorkspaceDriveFile(ctx),
+			"googleworkspace_drive_file_content":      tableGoogleWorkspaceDriveFileContent(ctx),
+			"googleworkspace_drive_folder_tree":       tableGoogleWorkspaceDriveFolderTree(ctx),
+			"googleworkspace_drive_label":             tableGoogleWorkspaceDriveLabel(ctx),
+			"googleworkspace_drive_member":            tableGoogleWorkspaceDriveMember(ctx),
 			"googleworkspace_drive_my_file":           tableGoogleWorkspaceDriveMyFile(ctx),
+			"googleworkspace_drive_permission":        tableGoogleWorkspaceDrivePermission(ctx),
+			"googleworkspace_drive_revision":          tableGoogleWorkspaceDriveRevision(ctx),
+			"googleworkspace_drive_start_page_token":  tableGoogleWorkspaceDriveStartPageToken(ctx),
 			"googleworkspace_gmail_draft":             tableGoogleWorkspaceGmailDraft(ctx),
 			"googleworkspace_gmail_message":           tableGoogleWorkspaceGmailMessage(ctx),
 			"googleworkspace_gmail_my_draft":          tableGoogleWorkspaceGmailMyDraft(ctx),
@@ -42,6 +67,8 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"googleworkspace_people_contact":          tableGoogleWorkspacePeopleContact(ctx),
 			"googleworkspace_people_contact_group":    tableGoogleWorkspacePeopleContactGroup(ctx),
 			"googleworkspace_people_directory_people": tableGoogleWorkspacePeopleDirectoryPeople(ctx),
+			"googleworkspace_usage_report_customer":   tableGoogleworkspaceUsageReportCustomer(ctx),
+			"googleworkspace_usage_report_user":       tableGoogleworkspaceUsageReportUser(ctx),
 		},
 	}
 
@@ -1,18 +1,27 @@
 package googleworkspace
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	directory "google.golang.org/api/admin/directory/v1"
 	admin "google.golang.org/api/admin/reports/v1"
 	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/discovery/v1"
 	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/drivelabels/v2"
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/option"
 	"google.golang.org/api/people/v1"
+	"google.golang.org/api/sheets/v4"
+	htransport "google.golang.org/api/transport/http"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
@@ -98,6 +107,99 @@ func DriveServiceWithScope(ctx context.Context, d *plugin.QueryData, scopes ...s
 	return svc, nil
 }
 
+func DriveLabelsServiceWithScope(ctx context.Context, d *plugin.QueryData, scopes ...string) (*drivelabels.Service, error) {
+	// Create cache key based on scopes
+	cacheKey := "googleworkspace.drivelabels - " + strings.Join(scopes, "|")
+
+	// have we already created and cached the service?
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*drivelabels.Service), nil
+	}
+
+	// so it was not in cache - create service
+	opts, err := getSessionConfig(ctx, d, scopes...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create service
+	svc, err := drivelabels.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// cache the service
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+
+	return svc, nil
+}
+
+// DriveServiceForUser returns a Drive service impersonating the given user, or the configured user if none is given
+func DriveServiceForUser(ctx context.Context, d *plugin.QueryData, userEmail string, scopes ...string) (*drive.Service, error) {
+	if userEmail == "" {
+		return DriveServiceWithScope(ctx, d, scopes...)
+	}
+
+	// Create cache key based on user and scopes
+	cacheKey := "googleworkspace.drive - " + userEmail + " - " + strings.Join(scopes, "|")
+
+	// have we already created and cached the service?
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*drive.Service), nil
+	}
+
+	// so it was not in cache - create service
+	opts, err := getUserSessionConfig(ctx, d, userEmail, scopes...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create service
+	svc, err := drive.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// cache the service
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+
+	return svc, nil
+}
+
+// SheetsServiceForUser returns a Sheets service impersonating the given user, or the configured user if none is given
+func SheetsServiceForUser(ctx context.Context, d *plugin.QueryData, userEmail string, scopes ...string) (*sheets.Service, error) {
+	// Create cache key based on user and scopes
+	cacheKey := "googleworkspace.sheets - " + userEmail + " - " + strings.Join(scopes, "|")
+
+	// have we already created and cached the service?
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*sheets.Service), nil
+	}
+
+	// so it was not in cache - create service
+	var opts []option.ClientOption
+	var err error
+	if userEmail != "" {
+		opts, err = getUserSessionConfig(ctx, d, userEmail, scopes...)
+	} else {
+		opts, err = getSessionConfig(ctx, d, scopes...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Create service
+	svc, err := sheets.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// cache the service
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+
+	return svc, nil
+}
+
 func GmailServiceWithScope(ctx context.Context, d *plugin.QueryData, scopes ...string) (*gmail.Service, error) {
 	// Create cache key based on scopes
 	cacheKey := "googleworkspace.gmail - " + strings.Join(scopes, "|")
@@ -140,14 +242,68 @@ func ReportsServiceWithScope(ctx context.Context, d *plugin.QueryData, scopes ..
 		return nil, err
 	}
 
+	// The client keeps the raw responses of the calls which ask for it, see rawResponseTransport
+	client, _, err := htransport.NewClient(ctx, append([]option.ClientOption{option.WithScopes(scopes...)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = &rawResponseTransport{base: client.Transport}
+
+	// Create service
+	svc, err := admin.NewService(ctx, option.WithHTTPClient(client))
+	if err != nil {
+		return nil, err
+	}
+
+	// cache the service
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+	return svc, nil
+}
+
+func DirectoryServiceWithScope(ctx context.Context, d *plugin.QueryData, scopes ...string) (*directory.Service, error) {
+	// Create cache key based on scopes
+	cacheKey := "googleworkspace.directory - " + strings.Join(scopes, "|")
+
+	// have we already created and cached the service?
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*directory.Service), nil
+	}
+
+	// so it was not in cache - create service
+	opts, err := getSessionConfig(ctx, d, scopes...)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create service
-	svc, err := admin.NewService(ctx, opts...)
+	svc, err := directory.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// cache the service
+	d.ConnectionManager.Cache.Set(cacheKey, svc)
+
+	return svc, nil
+}
+
+func DiscoveryService(ctx context.Context, d *plugin.QueryData) (*discovery.Service, error) {
+	cacheKey := "googleworkspace.discovery"
+
+	// have we already created and cached the service?
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*discovery.Service), nil
+	}
+
+	// The discovery documents are public, so the service doesn't need to be authenticated
+	svc, err := discovery.NewService(ctx, option.WithoutAuthentication())
 	if err != nil {
 		return nil, err
 	}
 
 	// cache the service
 	d.ConnectionManager.Cache.Set(cacheKey, svc)
+
 	return svc, nil
 }
 
@@ -193,20 +349,51 @@ func getSessionConfig(ctx context.Context, d *plugin.QueryData, scopes ...string
 	return nil, nil
 }
 
+// getUserSessionConfig returns the client options to impersonate the given user, which requires domain-wide delegation
+func getUserSessionConfig(ctx context.Context, d *plugin.QueryData, userEmail string, scopes ...string) ([]option.ClientOption, error) {
+	googleworkspaceConfig := GetConfig(d.Connection)
+	if googleworkspaceConfig.Credentials == nil && googleworkspaceConfig.CredentialFile == nil {
+		return nil, fmt.Errorf("credentials must be configured to impersonate %s", userEmail)
+	}
+
+	ts, err := getUserTokenSource(ctx, d, userEmail, scopes...)
+	if err != nil {
+		return nil, err
+	}
+
+	return []option.ClientOption{option.WithTokenSource(ts)}, nil
+}
+
 // Returns a JWT TokenSource using the configuration and the HTTP client from the provided context.
 func getTokenSource(ctx context.Context, d *plugin.QueryData, scopes ...string) (oauth2.TokenSource, error) {
+	// Get user to impersonate from config (if mentioned)
+	var impersonateUser string
+	googleworkspaceConfig := GetConfig(d.Connection)
+
+	if googleworkspaceConfig.ImpersonatedUserEmail != nil {
+		impersonateUser = *googleworkspaceConfig.ImpersonatedUserEmail
+	}
+
+	// Return error, since impersonation required to authenticate using domain-wide delegation
+	if impersonateUser == "" {
+		return nil, errors.New("impersonated_user_email must be configured")
+	}
+
+	return getUserTokenSource(ctx, d, impersonateUser, scopes...)
+}
+
+// Returns a JWT TokenSource impersonating the given user, using domain-wide delegation.
+func getUserTokenSource(ctx context.Context, d *plugin.QueryData, userEmail string, scopes ...string) (oauth2.TokenSource, error) {
 	// Note: based on https://developers.google.com/admin-sdk/directory/v1/guides/delegation#go
 
-	// Create cache key based on scopes
-	cacheKey := "googleworkspace.token_source." + strings.Join(scopes, "-")
+	// Create cache key based on user and scopes
+	cacheKey := "googleworkspace.token_source." + userEmail + "." + strings.Join(scopes, "-")
 
 	// have we already created and cached the token?
 	if ts, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return ts.(oauth2.TokenSource), nil
 	}
 
-	// Get credential file path, and user to impersonate from config (if mentioned)
-	var impersonateUser string
 	googleworkspaceConfig := GetConfig(d.Connection)
 
 	// Read credential from JSON string, or from the given path
@@ -225,15 +412,6 @@ func getTokenSource(ctx context.Context, d *plugin.QueryData, scopes ...string)
 		return nil, err
 	}
 
-	if googleworkspaceConfig.ImpersonatedUserEmail != nil {
-		impersonateUser = *googleworkspaceConfig.ImpersonatedUserEmail
-	}
-
-	// Return error, since impersonation required to authenticate using domain-wide delegation
-	if impersonateUser == "" {
-		return nil, errors.New("impersonated_user_email must be configured")
-	}
-
 	// Authorize the request
 	config, err := google.JWTConfigFromJSON(
 		[]byte(credentialContent),
@@ -242,7 +420,7 @@ func getTokenSource(ctx context.Context, d *plugin.QueryData, scopes ...string)
 	if err != nil {
 		return nil, err
 	}
-	config.Subject = impersonateUser
+	config.Subject = userEmail
 
 	ts := config.TokenSource(ctx)
 
@@ -251,3 +429,31 @@ func getTokenSource(ctx context.Context, d *plugin.QueryData, scopes ...string)
 
 	return ts, nil
 }
+
+// rawResponseKey is the context key of the buffer where rawResponseTransport copies the body of the response
+type rawResponseKey struct{}
+
+// rawResponseTransport copies the body of the responses to the buffer set in the context of the request, if any.
+// The client libraries drop the fields which have a zero value, so the raw response is the only way to tell
+// whether they were present.
+type rawResponseTransport struct {
+	base http.RoundTripper
+}
+
+func (t *rawResponseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	raw, ok := req.Context().Value(rawResponseKey{}).(*[]byte)
+	if err != nil || !ok {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	*raw = body
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
@@ -0,0 +1,250 @@
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/googleapi"
+)
+
+type activityApplication = struct {
+	Name        string
+	Description string
+	Source      string
+}
+
+// Application names supported by the Reports API at the time of writing, used if the discovery document is not available
+var builtinActivityApplications = []activityApplication{
+	{Name: "access_transparency", Description: "The Google Workspace Access Transparency activity reports return information about different types of Access Transparency activity events."},
+	{Name: "admin", Description: "The Admin console application's activity reports return account information about different types of administrator activity events."},
+	{Name: "calendar", Description: "The Google Calendar application's activity reports return information about various Calendar activity events."},
+	{Name: "chat", Description: "The Chat activity reports return information about various Chat activity events."},
+	{Name: "drive", Description: "The Google Drive application's activity reports return information about various Google Drive activity events. The Drive activity report is only available for Google Workspace Business and Enterprise customers."},
+	{Name: "gcp", Description: "The Google Cloud Platform application's activity reports return information about various GCP activity events."},
+	{Name: "gplus", Description: "The Google+ application's activity reports return information about various Google+ activity events."},
+	{Name: "groups", Description: "The Google Groups application's activity reports return information about various Groups activity events."},
+	{Name: "groups_enterprise", Description: "The Enterprise Groups activity reports return information about various Enterprise group activity events."},
+	{Name: "jamboard", Description: "The Jamboard activity reports return information about various Jamboard activity events."},
+	{Name: "login", Description: "The Login application's activity reports return account information about different types of Login activity events."},
+	{Name: "meet", Description: "The Meet Audit activity report returns information about different types of Meet Audit activity events."},
+	{Name: "mobile", Description: "The Device Audit activity report returns information about different types of Device Audit activity events."},
+	{Name: "rules", Description: "The Rules activity report returns information about different types of Rules activity events."},
+	{Name: "saml", Description: "The SAML activity report returns information about different types of SAML activity events."},
+	{Name: "token", Description: "The Token application's activity reports return account information about different types of Token activity events."},
+	{Name: "user_accounts", Description: "The User Accounts application's activity reports return account information about different types of User Accounts activity events."},
+	{Name: "context_aware_access", Description: "The Context-aware access activity reports return information about users' access denied events due to Context-aware access rules."},
+	{Name: "chrome", Description: "The Chrome activity reports return information about Chrome browser and Chrome OS events."},
+	{Name: "data_studio", Description: "The Data Studio activity reports return information about various types of Data Studio activity events."},
+	{Name: "keep", Description: "The Keep application's activity reports return information about various Google Keep activity events. The Keep activity report is only available for Google Workspace Business and Enterprise customers."},
+	{Name: "vault", Description: "The Vault activity reports return information about various types of Vault activity events."},
+	{Name: "gemini_in_workspace_apps", Description: "The Gemini for Workspace activity reports return information about various types of Gemini activity events performed by users within a Workspace application."},
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceActivityApplication(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_activity_application",
+		Description: "Applications supported by the Google Workspace Activity Report.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listGoogleworkspaceActivityApplications,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The application name, as used by the application_name column of the activity report tables.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description of the activity reports of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source",
+				Description: "The source of the application name. Possible values are: config, discovery and builtin.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_catalog_url",
+				Description: "A link to the catalog of the event names and parameters of the application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(activityEventCatalogURL),
+			},
+			{
+				Name:        "event_names",
+				Description: "The distinct names of the events found in the 1000 most recent activities of the application. This is a sample, not a catalog of the event names: it is empty for applications without recent activity, and may be incomplete for busy ones. Null if the activities of the application are not available to the customer. See event_catalog_url for the full list.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listGoogleworkspaceActivityApplicationEventNames,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceActivityApplications(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	for _, application := range getActivityApplications(ctx, d) {
+		d.StreamListItem(ctx, application)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listGoogleworkspaceActivityApplicationEventNames(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// https://developers.google.com/admin-sdk/reports/v1/reference/activities/list#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsAuditReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	appName := h.Item.(activityApplication).Name
+
+	resp, err := service.Activities.List("all", appName).MaxResults(1000).Fields("items(events(name))").Do()
+	if err != nil {
+		// Applications which are not enabled or licensed for the customer have no activities to sample
+		if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 400 || gerr.Code == 403 || gerr.Code == 404) {
+			plugin.Logger(ctx).Warn("googleworkspace_activity_application.listGoogleworkspaceActivityApplicationEventNames", "application_name", appName, "api_error", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	names := []string{}
+	for _, activity := range resp.Items {
+		for _, event := range activity.Events {
+			if event.Name != "" && !slices.Contains(names, event.Name) {
+				names = append(names, event.Name)
+			}
+		}
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getActivityApplications returns the application names configured in the connection, or else the application
+// names listed in the Reports API discovery document, along with any built-in application names missing from it
+func getActivityApplications(ctx context.Context, d *plugin.QueryData) []activityApplication {
+	config := GetConfig(d.Connection)
+	if len(config.ActivityApplicationNames) > 0 {
+		var applications []activityApplication
+		for _, name := range config.ActivityApplicationNames {
+			application := activityApplication{Name: name, Source: "config"}
+			if i := slices.IndexFunc(builtinActivityApplications, func(a activityApplication) bool { return a.Name == name }); i >= 0 {
+				application.Description = builtinActivityApplications[i].Description
+			}
+			applications = append(applications, application)
+		}
+		return applications
+	}
+
+	cacheKey := "googleworkspace.activity_applications"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]activityApplication)
+	}
+
+	applications, err := listDiscoveredActivityApplications(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Warn("getActivityApplications", "message", "unable to read the Reports API discovery document, using the built-in application names", "error", err)
+	}
+
+	for _, application := range builtinActivityApplications {
+		if !slices.ContainsFunc(applications, func(a activityApplication) bool { return a.Name == application.Name }) {
+			application.Source = "builtin"
+			applications = append(applications, application)
+		}
+	}
+
+	// Cache the built-in application names briefly, so that the discovery document is read again later
+	if err != nil {
+		d.ConnectionManager.Cache.SetWithTTL(cacheKey, applications, 5*time.Minute)
+	} else {
+		d.ConnectionManager.Cache.Set(cacheKey, applications)
+	}
+
+	return applications
+}
+
+// listDiscoveredActivityApplications returns the application names listed in the Reports API discovery document
+func listDiscoveredActivityApplications(ctx context.Context, d *plugin.QueryData) ([]activityApplication, error) {
+	service, err := DiscoveryService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := service.Apis.GetRest("admin", "reports_v1").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	param, ok := resp.Resources["activities"].Methods["list"].Parameters["applicationName"]
+	if !ok || len(param.Enum) == 0 {
+		return nil, fmt.Errorf("applicationName values not found in the discovery document")
+	}
+
+	var applications []activityApplication
+	for i, name := range param.Enum {
+		application := activityApplication{Name: name, Source: "discovery"}
+		if i < len(param.EnumDescriptions) {
+			application.Description = param.EnumDescriptions[i]
+		}
+		applications = append(applications, application)
+	}
+
+	return applications, nil
+}
+
+// validateActivityApplicationName returns an error if the given application name is not supported,
+// suggesting the closest supported application name, if any
+func validateActivityApplicationName(ctx context.Context, d *plugin.QueryData, appName string) error {
+	applications := getActivityApplications(ctx, d)
+
+	var names []string
+	for _, application := range applications {
+		if application.Name == appName {
+			return nil
+		}
+		names = append(names, application.Name)
+	}
+
+	// Suggest the closest application name, if it is close enough to be a typo
+	suggestion, bestDistance := "", 4
+	for _, name := range names {
+		if distance := levenshteinDistance(strings.ToLower(appName), name); distance < bestDistance {
+			suggestion, bestDistance = name, distance
+		}
+	}
+	if suggestion != "" {
+		return fmt.Errorf("unsupported application_name: %q, did you mean %q?", appName, suggestion)
+	}
+
+	return fmt.Errorf("unsupported application_name: %q, supported values are: %s", appName, strings.Join(names, ", "))
+}
+
+//// TRANSFORM FUNCTIONS
+
+func activityEventCatalogURL(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name, ok := d.Value.(string)
+	if !ok || name == "" {
+		return nil, nil
+	}
+	return "https://developers.google.com/workspace/admin/reports/v1/appendix/activity/" + strings.ReplaceAll(name, "_", "-"), nil
+}
@@ -0,0 +1,261 @@
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/reports/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceActivityChannel(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_activity_channel",
+		Description: "Push notification channels for Google Workspace activities, created by the plugin.",
+		// Creating and stopping channels has side effects, and the status of the channels changes over time
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+
+		List: &plugin.ListConfig{
+			Hydrate: listGoogleworkspaceActivityChannels,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "action", Require: plugin.Optional},
+				{Name: "id", Require: plugin.Optional},
+				{Name: "application_name", Require: plugin.Optional},
+				{Name: "user_key", Require: plugin.Optional},
+				{Name: "event_name", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "reports", "action": "activities.watch"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "A UUID or similar unique string that identifies this channel.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Channel.Id").NullIfZero(),
+			},
+			{
+				Name:        "application_name",
+				Description: "Application name for which the activities are watched.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the channel. Possible values are: active, expired and stopped.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "An opaque ID that identifies the resource being watched on this channel.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Channel.ResourceId").NullIfZero(),
+			},
+			{
+				Name:        "resource_uri",
+				Description: "A version-specific identifier for the watched resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Channel.ResourceUri").NullIfZero(),
+			},
+			{
+				Name:        "address",
+				Description: "The address where notifications are delivered for this channel.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Channel.Address").NullIfZero(),
+			},
+			{
+				Name:        "user_key",
+				Description: "The profile ID or the user email for which the activities are watched. Defaults to `all`.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the event watched, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_time",
+				Description: "The time at which the channel was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time at which the channel expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Channel.Expiration").Transform(transform.NullIfZeroValue).Transform(transform.UnixMsToTimestamp),
+			},
+			{
+				Name:        "action",
+				Description: "The action to perform, if any. Possible values are: create, to watch the activities of the given `application_name`, and stop, to stop the channel with the given `id`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("action"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceActivityChannels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	receiver, err := getActivityWebhookReceiver(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_activity_channel.listGoogleworkspaceActivityChannels", "receiver_error", err)
+		return nil, err
+	}
+
+	switch action := d.EqualsQualString("action"); action {
+	case "create":
+		channel, err := createActivityChannel(ctx, d, receiver)
+		if err != nil {
+			plugin.Logger(ctx).Error("googleworkspace_activity_channel.listGoogleworkspaceActivityChannels", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, *channel)
+	case "stop":
+		channel, err := stopActivityChannel(ctx, d, receiver)
+		if err != nil {
+			plugin.Logger(ctx).Error("googleworkspace_activity_channel.listGoogleworkspaceActivityChannels", "api_error", err)
+			return nil, err
+		}
+		d.StreamListItem(ctx, *channel)
+	case "":
+		for _, channel := range receiver.listChannels() {
+			d.StreamListItem(ctx, channel)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported action: %q, supported values are: create, stop", action)
+	}
+
+	return nil, nil
+}
+
+// createActivityChannel watches the activities of the queried application, and registers the channel with the receiver
+func createActivityChannel(ctx context.Context, d *plugin.QueryData, receiver *activityWebhookReceiver) (*activityChannel, error) {
+	// https://developers.google.com/workspace/admin/reports/reference/rest/v1/activities/watch#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsAuditReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	config := GetConfig(d.Connection)
+	if config.ActivityWebhookAddress == nil || *config.ActivityWebhookAddress == "" {
+		return nil, errors.New("activity_webhook_address must be configured to create an activity channel")
+	}
+
+	appName := d.EqualsQualString("application_name")
+	if appName == "" {
+		return nil, errors.New("application_name must be specified to create an activity channel")
+	}
+	if err := validateActivityApplicationName(ctx, d, appName); err != nil {
+		return nil, err
+	}
+
+	// Determine userKey: default to "all", or use the user_key if provided
+	userKey := "all"
+	if uk := d.EqualsQualString("user_key"); uk != "" {
+		userKey = uk
+	}
+
+	id, err := newActivityChannelSecret()
+	if err != nil {
+		return nil, err
+	}
+	token, err := newActivityChannelSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	channel := &activityChannel{
+		Channel: admin.Channel{
+			Id:      id,
+			Token:   token,
+			Type:    "web_hook",
+			Address: *config.ActivityWebhookAddress,
+		},
+		ApplicationName: appName,
+		UserKey:         userKey,
+		EventName:       d.EqualsQualString("event_name"),
+		CreatedTime:     time.Now().UTC(),
+		Status:          "active",
+		ListenAddress:   *config.ActivityWebhookListenAddress,
+	}
+
+	// Return the active channel watching the same activities, if any, so that creating a channel is idempotent
+	if existing, ok := receiver.findActiveChannel(channel); ok {
+		return &existing, nil
+	}
+
+	// Register the channel before watching, since the receiver gets a sync message as soon as the channel is created
+	receiver.addChannel(ctx, channel)
+
+	req := &admin.Channel{
+		Id:      channel.Channel.Id,
+		Token:   channel.Channel.Token,
+		Type:    channel.Channel.Type,
+		Address: channel.Channel.Address,
+	}
+	call := service.Activities.Watch(userKey, appName, req)
+	if channel.EventName != "" {
+		call = call.EventName(channel.EventName)
+	}
+	resp, err := call.Do()
+	if err != nil {
+		receiver.stopChannel(ctx, channel.Channel.Id)
+		return nil, err
+	}
+
+	result := receiver.updateChannel(ctx, channel, func(c *activityChannel) {
+		c.Channel.ResourceId = resp.ResourceId
+		c.Channel.ResourceUri = resp.ResourceUri
+		c.Channel.Expiration = resp.Expiration
+	})
+
+	return &result, nil
+}
+
+// stopActivityChannel stops the channel with the queried ID
+func stopActivityChannel(ctx context.Context, d *plugin.QueryData, receiver *activityWebhookReceiver) (*activityChannel, error) {
+	// https://developers.google.com/workspace/admin/reports/reference/rest/v1/channels/stop#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsAuditReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	id := d.EqualsQualString("id")
+	if id == "" {
+		return nil, errors.New("id must be specified to stop an activity channel")
+	}
+
+	channel, ok := receiver.getChannel(id)
+	if !ok {
+		return nil, fmt.Errorf("activity channel %q was not created by this plugin", id)
+	}
+
+	// Return the channel as is, if it has already been stopped, so that stopping a channel is idempotent
+	if channel.Status == "stopped" {
+		return &channel, nil
+	}
+
+	req := &admin.Channel{
+		Id:         channel.Channel.Id,
+		ResourceId: channel.Channel.ResourceId,
+	}
+	if err := service.Channels.Stop(req).Do(); err != nil {
+		return nil, err
+	}
+	receiver.stopChannel(ctx, id)
+
+	channel, _ = receiver.getChannel(id)
+	return &channel, nil
+}
@@ -0,0 +1,131 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceActivityNotification(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_activity_notification",
+		Description: "Google Workspace activities delivered through push notification channels, buffered by the plugin.",
+		// The notifications are buffered as they are received, so they must not be served from the cache
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+
+		List: &plugin.ListConfig{
+			Hydrate: listGoogleworkspaceActivityNotifications,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "channel_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "channel_id",
+				Description: "The ID of the channel the notification was delivered through.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "message_number",
+				Description: "The sequence number of the notification in the channel.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "resource_state",
+				Description: "The state of the notification. The first notification of a channel is a `sync` message, without any activity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "received_time",
+				Description: "The time at which the notification was received.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "time",
+				Description: "Time of occurrence of the activity.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Activity.Id.Time"),
+			},
+			{
+				Name:        "actor_email",
+				Description: "Email address of the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Actor.Email"),
+			},
+			{
+				Name:        "event_names",
+				Description: "List of event names for this activity.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Activity.Events").Transform(extractEventNames),
+			},
+			{
+				Name:        "unique_qualifier",
+				Description: "Unique qualifier ID for this activity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.UniqueQualifier"),
+			},
+			{
+				Name:        "application_name",
+				Description: "Application name to which the event belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.ApplicationName"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "IP address associated with the activity.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Activity.IpAddress").NullIfZero(),
+			},
+			{
+				Name:        "actor_profile_id",
+				Description: "The unique Google Workspace profile ID of the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Actor.ProfileId"),
+			},
+			{
+				Name:        "customer_id",
+				Description: "The unique ID of the customer to retrieve data for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.CustomerId"),
+			},
+			{
+				Name:        "events",
+				Description: "Activity events in the notification.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Activity.Events"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceActivityNotifications(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	receiver, err := getActivityWebhookReceiver(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_activity_notification.listGoogleworkspaceActivityNotifications", "receiver_error", err)
+		return nil, err
+	}
+
+	channelID := d.EqualsQualString("channel_id")
+
+	for _, notification := range receiver.listNotifications() {
+		if channelID != "" && notification.ChannelId != channelID {
+			continue
+		}
+		d.StreamListItem(ctx, notification)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
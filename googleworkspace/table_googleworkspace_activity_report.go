@@ -2,7 +2,10 @@ package googleworkspace
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -10,8 +13,69 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"github.com/turbot/steampipe-plugin-sdk/v5/query_cache"
 	admin "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/googleapi"
 )
 
+const (
+	// The Reports API retains activity data for 180 days
+	activityRetentionPeriod = 180 * 24 * time.Hour
+
+	// The minimum duration of a time window fetched concurrently
+	activityMinWindow = time.Hour
+
+	// The default number of time windows fetched concurrently
+	defaultActivityReportMaxConcurrency = 5
+
+	// The time format accepted by the Reports API, with millisecond precision
+	activityTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// activityReportKeyColumns returns the key columns common to the activity report tables
+func activityReportKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{Name: "application_name", Require: plugin.Required},
+		{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+		{Name: "actor_email", Require: plugin.Optional},
+		{Name: "ip_address", Require: plugin.Optional},
+		{Name: "event_name", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
+		{Name: "customer_id", Require: plugin.Optional},
+		{Name: "org_unit_id", Require: plugin.Optional},
+		{Name: "group_id_filter", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
+		{Name: "parameter_filter", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
+		{Name: "resource_details_filter", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
+	}
+}
+
+// activityReportFilterColumns returns the columns, common to the activity report tables, used to filter the activities
+func activityReportFilterColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "org_unit_id",
+			Description: "The ID of the organizational unit to report on. Only activities of users belonging to the organizational unit are returned.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("org_unit_id"),
+		},
+		{
+			Name:        "group_id_filter",
+			Description: "A comma-separated list of group IDs, for example `id:abc123,id:xyz456`. Only activities of users belonging to the groups are returned.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("group_id_filter"),
+		},
+		{
+			Name:        "parameter_filter",
+			Description: "A comma-separated list of filters on event parameter values, for example `doc_id==abc`.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("parameter_filter"),
+		},
+		{
+			Name:        "resource_details_filter",
+			Description: "A filter on the resource details of the activities, for example `resourceDetails.id==abc`.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("resource_details_filter"),
+		},
+	}
+}
+
 //// TABLE DEFINITION
 
 func tableGoogleworkspaceActivityReport(ctx context.Context) *plugin.Table {
@@ -20,17 +84,11 @@ func tableGoogleworkspaceActivityReport(ctx context.Context) *plugin.Table {
 		Description: "Google Workspace Activity Report",
 
 		List: &plugin.ListConfig{
-			Hydrate: listGoogleworkspaceAdminReportsActivities,
-			KeyColumns: plugin.KeyColumnSlice{
-				{Name: "application_name", Require: plugin.Required},
-				{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
-				{Name: "actor_email", Require: plugin.Optional},
-				{Name: "ip_address", Require: plugin.Optional},
-				{Name: "event_name", Require: plugin.Optional, CacheMatch: query_cache.CacheMatchExact},
-			},
-			Tags: map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+			Hydrate:    listGoogleworkspaceAdminReportsActivities,
+			KeyColumns: activityReportKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
 		},
-		Columns: []*plugin.Column{
+		Columns: append([]*plugin.Column{
 			{
 				Name:        "time",
 				Description: "Time of occurrence of the activity.",
@@ -89,7 +147,7 @@ func tableGoogleworkspaceActivityReport(ctx context.Context) *plugin.Table {
 				Description: "Activity events in the report.",
 				Type:        proto.ColumnType_JSON,
 			},
-		},
+		}, activityReportFilterColumns()...),
 	}
 }
 
@@ -103,61 +161,118 @@ func listGoogleworkspaceAdminReportsActivities(ctx context.Context, d *plugin.Qu
 		return nil, err
 	}
 
-	// Required application_name qualifier
-	appName := d.EqualsQualString("application_name")
-
-	// Validate application_name
-	valid := map[string]bool{
-		"access_transparency":      true,
-		"admin":                    true,
-		"calendar":                 true,
-		"chat":                     true,
-		"drive":                    true,
-		"gcp":                      true,
-		"gplus":                    true,
-		"groups":                   true,
-		"groups_enterprise":        true,
-		"jamboard":                 true,
-		"login":                    true,
-		"meet":                     true,
-		"mobile":                   true,
-		"rules":                    true,
-		"saml":                     true,
-		"token":                    true,
-		"user_accounts":            true,
-		"context_aware_access":     true,
-		"chrome":                   true,
-		"data_studio":              true,
-		"keep":                     true,
-		"vault":                    true,
-		"gemini_in_workspace_apps": true,
-	}
-
-	if !valid[appName] {
-		return nil, fmt.Errorf("unsupported application_name: %q", appName)
+	err = listActivities(ctx, d, service, func(activity *admin.Activity, _ json.RawMessage) {
+		d.StreamListItem(ctx, activity)
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_activity_report.listGoogleworkspaceAdminReportsActivities", "api_error", err)
+		return nil, err
 	}
 
-	// Setting the maximum number of activities, API can return in a single page
-	maxResults := int64(1000)
+	return nil, nil
+}
 
-	limit := d.QueryContext.Limit
+//// UTILITY FUNCTIONS
+
+// listActivities calls the given function for each activity matching the query, along with the raw JSON of the activity. The requested time range
+// is split into windows, which are fetched concurrently.
+func listActivities(ctx context.Context, d *plugin.QueryData, service *admin.Service, streamActivity func(*admin.Activity, json.RawMessage)) error {
+	startTime, endTime, err := getActivityTimeRange(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	// Activities are returned in reverse chronological order, so fetch a single window
+	// if the query is limited to preserve the order of the results
+	concurrency := getActivityReportMaxConcurrency(d)
 	if d.QueryContext.Limit != nil {
-		if *limit < maxResults {
-			maxResults = *limit
+		concurrency = 1
+	}
+	windows := splitActivityTimeRange(startTime, endTime, concurrency)
+
+	// The resourceDetailsFilter parameter is not supported by the client library,
+	// so pass it as an additional query parameter
+	var opts []googleapi.CallOption
+	if qual := d.EqualsQualString("resource_details_filter"); qual != "" {
+		opts = append(opts, googleapi.QueryParameter("resourceDetailsFilter", qual))
+	}
+
+	listCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errorCh := make(chan error, len(windows))
+	for _, window := range windows {
+		resp, err := buildActivitiesListCall(ctx, d, service)
+		if err != nil {
+			return err
 		}
+		resp.StartTime(window[0].Format(activityTimeFormat)).EndTime(window[1].Format(activityTimeFormat))
+
+		wg.Add(1)
+		go func(resp *admin.ActivitiesListCall) {
+			defer wg.Done()
+			err := listActivitiesPages(listCtx, d, resp, opts, streamActivity)
+
+			// Ignore errors caused by the cancellation of the query, or of the other windows
+			if err != nil && d.RowsRemaining(listCtx) != 0 {
+				errorCh <- err
+				cancel()
+			}
+		}(resp)
 	}
+	wg.Wait()
+	close(errorCh)
 
-	// Determine userKey: default to "all", or use the actor_email if provided
-	userKey := "all"
-	if ae := d.EqualsQualString("actor_email"); ae != "" {
-		userKey = ae
+	return <-errorCh
+}
+
+// listActivitiesPages calls the given function for each activity returned by the call, following the pagination
+func listActivitiesPages(ctx context.Context, d *plugin.QueryData, resp *admin.ActivitiesListCall, opts []googleapi.CallOption, streamActivity func(*admin.Activity, json.RawMessage)) error {
+	for {
+		// rate limit
+		d.WaitForListRateLimit(ctx)
+
+		// Keep the raw response, since the client library drops the zero values of the event parameters
+		var raw []byte
+		page, err := resp.Context(context.WithValue(ctx, rawResponseKey{}, &raw)).Do(opts...)
+		if err != nil {
+			return err
+		}
+
+		var rawPage struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &rawPage); err != nil {
+			return err
+		}
+		if len(rawPage.Items) != len(page.Items) {
+			return errors.New("the raw response of the activities does not match the decoded activities")
+		}
+
+		for i, activity := range page.Items {
+			streamActivity(activity, rawPage.Items[i])
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return nil
+		}
+		resp.PageToken(page.NextPageToken)
 	}
+}
 
-	// Build API call with the chosen category
-	resp := service.Activities.List(userKey, appName).MaxResults(maxResults)
+// getActivityTimeRange returns the time range requested in the query, restricted to the retention period of the Reports API
+func getActivityTimeRange(ctx context.Context, d *plugin.QueryData) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	retentionStart := now.Add(-activityRetentionPeriod)
 
+	startTime, endTime := retentionStart, now
 	if quals := d.Quals["time"]; quals != nil {
-		var startTime, endTime time.Time
 		for _, q := range quals.Quals {
 			if ts := q.Value.GetTimestampValue(); ts != nil {
 				t := ts.AsTime()
@@ -171,14 +286,86 @@ func listGoogleworkspaceAdminReportsActivities(ctx context.Context, d *plugin.Qu
 				}
 			}
 		}
-		if !startTime.IsZero() {
-			resp.StartTime(startTime.Format(time.RFC3339))
+	}
+
+	if endTime.Before(retentionStart) {
+		return startTime, endTime, fmt.Errorf("activity data is only retained for 180 days, the requested time range ends at %s, before the earliest available time %s", endTime.Format(time.RFC3339), retentionStart.Format(time.RFC3339))
+	}
+	if startTime.Before(retentionStart) {
+		plugin.Logger(ctx).Warn("listActivities", "message", "activity data is only retained for 180 days, restricting the start of the requested time range", "start_time", retentionStart.Format(time.RFC3339))
+		startTime = retentionStart
+	}
+	if endTime.After(now) {
+		endTime = now
+	}
+	if endTime.Before(startTime) {
+		endTime = startTime
+	}
+
+	return startTime, endTime, nil
+}
+
+// splitActivityTimeRange splits the given time range into at most n consecutive windows of at least an hour
+func splitActivityTimeRange(startTime, endTime time.Time, n int) [][2]time.Time {
+	duration := endTime.Sub(startTime)
+	if maxWindows := int(duration / activityMinWindow); n > maxWindows {
+		n = maxWindows
+	}
+	if n <= 1 {
+		return [][2]time.Time{{startTime, endTime}}
+	}
+
+	var windows [][2]time.Time
+	windowSize := duration / time.Duration(n)
+	for i := 0; i < n; i++ {
+		windowStart := startTime.Add(time.Duration(i) * windowSize)
+		windowEnd := windowStart.Add(windowSize - time.Millisecond)
+		if i == n-1 {
+			windowEnd = endTime
 		}
-		if !endTime.IsZero() {
-			resp.EndTime(endTime.Format(time.RFC3339))
+		windows = append(windows, [2]time.Time{windowStart, windowEnd})
+	}
+	return windows
+}
+
+// getActivityReportMaxConcurrency returns the maximum number of time windows fetched concurrently
+func getActivityReportMaxConcurrency(d *plugin.QueryData) int {
+	config := GetConfig(d.Connection)
+	if config.ActivityReportMaxConcurrency != nil && *config.ActivityReportMaxConcurrency > 0 {
+		return *config.ActivityReportMaxConcurrency
+	}
+	return defaultActivityReportMaxConcurrency
+}
+
+// buildActivitiesListCall builds the activities.list call for the application and the filters given in the query
+func buildActivitiesListCall(ctx context.Context, d *plugin.QueryData, service *admin.Service) (*admin.ActivitiesListCall, error) {
+	// Required application_name qualifier
+	appName := d.EqualsQualString("application_name")
+
+	// Validate application_name
+	if err := validateActivityApplicationName(ctx, d, appName); err != nil {
+		return nil, err
+	}
+
+	// Setting the maximum number of activities, API can return in a single page
+	maxResults := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResults {
+			maxResults = *limit
 		}
 	}
 
+	// Determine userKey: default to "all", or use the actor_email if provided
+	userKey := "all"
+	if ae := d.EqualsQualString("actor_email"); ae != "" {
+		userKey = ae
+	}
+
+	// Build API call with the chosen category
+	resp := service.Activities.List(userKey, appName).MaxResults(maxResults)
+
 	if qual := d.EqualsQuals["ip_address"]; qual != nil {
 		address := qual.GetInetValue().GetAddr()
 		resp = resp.ActorIpAddress(address)
@@ -188,41 +375,39 @@ func listGoogleworkspaceAdminReportsActivities(ctx context.Context, d *plugin.Qu
 		resp = resp.EventName(qual)
 	}
 
-	err = resp.Pages(ctx, func(page *admin.Activities) error {
-		// rate limit
-		d.WaitForListRateLimit(ctx)
+	if qual := d.EqualsQualString("customer_id"); qual != "" {
+		resp = resp.CustomerId(qual)
+	}
 
-		for _, activity := range page.Items {
-			d.StreamListItem(ctx, activity)
+	if qual := d.EqualsQualString("org_unit_id"); qual != "" {
+		resp = resp.OrgUnitID(qual)
+	}
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				page.NextPageToken = ""
-				break
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		plugin.Logger(ctx).Error("googleworkspace_activity_report.listGoogleworkspaceAdminReportsActivities", "api_error", err)
-		return nil, err
+	// Filter on the groups of the actors, for example "id:abc123,id:xyz456"
+	if qual := d.EqualsQualString("group_id_filter"); qual != "" {
+		resp = resp.GroupIdFilter(qual)
 	}
 
-	return nil, nil
+	// Filter on event parameter values, for example "doc_id==abc"
+	if qual := d.EqualsQualString("parameter_filter"); qual != "" {
+		resp = resp.Filters(qual)
+	}
+
+	return resp, nil
 }
 
 //// TRANSFORM FUNCTIONS
 
 func extractEventNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	activity, ok := d.HydrateItem.(*admin.Activity)
+	events, ok := d.Value.([]*admin.ActivityEvents)
 	if !ok {
 		return nil, nil
 	}
-	if activity.Events == nil {
+	if events == nil {
 		return nil, nil
 	}
 	names := []string{}
-	for _, e := range activity.Events {
+	for _, e := range events {
 		if e.Name != "" {
 			names = append(names, e.Name)
 		}
@@ -0,0 +1,206 @@
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/googleapi"
+)
+
+type activityReportEvent = struct {
+	Activity   *admin.Activity
+	EventType  string
+	EventName  string
+	Parameters map[string]interface{}
+}
+
+// activityEventParameter is a parameter of an event, or a nested parameter of a message value, decoded from the raw
+// response. Unlike the types of the client library, the values are pointers, so that a zero value can be told apart
+// from a missing one.
+type activityEventParameter struct {
+	Name              string                  `json:"name"`
+	Value             *string                 `json:"value"`
+	MultiValue        []string                `json:"multiValue"`
+	IntValue          *int64                  `json:"intValue,string"`
+	MultiIntValue     googleapi.Int64s        `json:"multiIntValue"`
+	BoolValue         *bool                   `json:"boolValue"`
+	MultiBoolValue    []bool                  `json:"multiBoolValue"`
+	MessageValue      *activityEventMessage   `json:"messageValue"`
+	MultiMessageValue []*activityEventMessage `json:"multiMessageValue"`
+}
+
+type activityEventMessage struct {
+	Parameter []*activityEventParameter `json:"parameter"`
+}
+
+// rawActivityEvents holds the parameters of the events of the raw activity
+type rawActivityEvents struct {
+	Events []struct {
+		Parameters []*activityEventParameter `json:"parameters"`
+	} `json:"events"`
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceActivityReportEvent(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_activity_report_event",
+		Description: "Google Workspace Activity Report events, with one row per event.",
+
+		List: &plugin.ListConfig{
+			Hydrate:    listGoogleworkspaceAdminReportsActivityEvents,
+			KeyColumns: activityReportKeyColumns(),
+			Tags:       map[string]string{"service": "admin", "product": "reports", "action": "activities.list"},
+		},
+		Columns: append([]*plugin.Column{
+			{
+				Name:        "time",
+				Description: "Time of occurrence of the activity.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Activity.Id.Time"),
+			},
+			{
+				Name:        "actor_email",
+				Description: "Email address of the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Actor.Email"),
+			},
+			{
+				Name:        "event_type",
+				Description: "Type of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_name",
+				Description: "Name of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parameters",
+				Description: "Parameter value pairs of the event, keyed by the parameter name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "unique_qualifier",
+				Description: "Unique qualifier ID for this activity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.UniqueQualifier"),
+			},
+			{
+				Name:        "application_name",
+				Description: "Application name to which the event belongs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.ApplicationName"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "IP address associated with the activity.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Activity.IpAddress").NullIfZero(),
+			},
+			{
+				Name:        "actor_profile_id",
+				Description: "The unique Google Workspace profile ID of the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Actor.ProfileId"),
+			},
+			{
+				Name:        "customer_id",
+				Description: "The unique ID of the customer to retrieve data for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Activity.Id.CustomerId"),
+			},
+		}, activityReportFilterColumns()...),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceAdminReportsActivityEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// https://developers.google.com/admin-sdk/reports/v1/reference/activities/list#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsAuditReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_activity_report_event.listGoogleworkspaceAdminReportsActivityEvents", "service_error", err)
+		return nil, err
+	}
+
+	err = listActivities(ctx, d, service, func(activity *admin.Activity, raw json.RawMessage) {
+		var rawActivity rawActivityEvents
+		if err := json.Unmarshal(raw, &rawActivity); err != nil {
+			plugin.Logger(ctx).Warn("googleworkspace_activity_report_event.listGoogleworkspaceAdminReportsActivityEvents", "parse_error", err)
+		}
+
+		for i, event := range activity.Events {
+			if event == nil {
+				continue
+			}
+			var parameters []*activityEventParameter
+			if i < len(rawActivity.Events) {
+				parameters = rawActivity.Events[i].Parameters
+			}
+			d.StreamListItem(ctx, activityReportEvent{
+				Activity:   activity,
+				EventType:  event.Type,
+				EventName:  event.Name,
+				Parameters: activityEventParameters(parameters),
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return
+			}
+		}
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_activity_report_event.listGoogleworkspaceAdminReportsActivityEvents", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// activityEventParameters returns the event parameters, or the nested parameters of a message value, as a map keyed
+// by the parameter name, with the value of each parameter in its native type. The value of a parameter without any
+// value is null.
+func activityEventParameters(parameters []*activityEventParameter) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, param := range parameters {
+		if param == nil || param.Name == "" {
+			continue
+		}
+
+		switch {
+		case param.Value != nil:
+			result[param.Name] = *param.Value
+		case param.MultiValue != nil:
+			result[param.Name] = param.MultiValue
+		case param.IntValue != nil:
+			result[param.Name] = *param.IntValue
+		case param.MultiIntValue != nil:
+			result[param.Name] = param.MultiIntValue
+		case param.BoolValue != nil:
+			result[param.Name] = *param.BoolValue
+		case param.MultiBoolValue != nil:
+			result[param.Name] = param.MultiBoolValue
+		case param.MessageValue != nil:
+			result[param.Name] = activityEventParameters(param.MessageValue.Parameter)
+		case param.MultiMessageValue != nil:
+			values := []map[string]interface{}{}
+			for _, message := range param.MultiMessageValue {
+				if message != nil {
+					values = append(values, activityEventParameters(message.Parameter))
+				}
+			}
+			result[param.Name] = values
+		default:
+			result[param.Name] = nil
+		}
+	}
+	return result
+}
@@ -0,0 +1,154 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceBuilding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_building",
+		Description: "Buildings of the organization, where the calendar resources are located.",
+		List: &plugin.ListConfig{
+			Hydrate: listBuildings,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("building_id"),
+			Hydrate:           getBuilding,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "building_id",
+				Description: "Unique identifier for the building.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "building_name",
+				Description: "The building name as seen by users in Calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A brief description of the building.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "latitude",
+				Description: "Latitude of the building in decimal degree.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coordinates.Latitude"),
+			},
+			{
+				Name:        "longitude",
+				Description: "Longitude of the building in decimal degree.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Coordinates.Longitude"),
+			},
+			{
+				Name:        "region_code",
+				Description: "CLDR region code of the country or region of the address of the building.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Address.RegionCode"),
+			},
+			{
+				Name:        "locality",
+				Description: "The city or town of the address of the building.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Address.Locality"),
+			},
+			{
+				Name:        "etags",
+				Description: "ETag of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "floor_names",
+				Description: "The display names for all floors in the building, ordered from the lowest to the highest floor.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "address",
+				Description: "The postal address of the building.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "coordinates",
+				Description: "The geographic coordinates of the center of the building.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listBuildings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.buildings/list#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// By default, API can return maximum 500 records in a single page
+	maxResult := int64(500)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	resp := service.Resources.Buildings.List(directoryMyCustomer).MaxResults(maxResult)
+	if err := resp.Pages(ctx, func(page *directory.Buildings) error {
+		for _, building := range page.Buildings {
+			d.StreamListItem(ctx, building)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBuilding(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBuilding")
+
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.buildings/get#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	buildingID := d.EqualsQualString("building_id")
+
+	// Return nil, if no input provided
+	if buildingID == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Resources.Buildings.Get(directoryMyCustomer, buildingID).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
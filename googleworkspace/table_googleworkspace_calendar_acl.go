@@ -0,0 +1,199 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type calendarAclRule = struct {
+	CalendarId      string
+	CalendarSummary string
+	calendar.AclRule
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarAcl(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_acl",
+		Description: "Access control rules of the calendars, which define who they are shared with.",
+		List: &plugin.ListConfig{
+			Hydrate:           listCalendarAclRules,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "calendar_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "show_deleted",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.AllColumns([]string{"calendar_id", "id"}),
+			Hydrate:           getCalendarAclRule,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "calendar_id",
+				Description: "Identifier of the calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "calendar_summary",
+				Description: "Title of the calendar. Only populated when the calendars are listed from the calendar list of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Identifier of the access control rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The role assigned to the scope. Possible values are: none, freeBusyReader, reader, writer and owner. Deleted rules have the none role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope_type",
+				Description: "The type of the scope. Possible values are: default for the public scope, user, group and domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Type"),
+			},
+			{
+				Name:        "scope_value",
+				Description: "The email address of a user or group, or the name of a domain, depending on the scope type. Omitted for the default scope.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope.Value"),
+			},
+			{
+				Name:        "etag",
+				Description: "ETag of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "show_deleted",
+				Description: "Whether to include deleted access control rules, which have the none role. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("show_deleted"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarAclRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/acl/list#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarScope)
+	if err != nil {
+		return nil, err
+	}
+
+	var showDeleted bool
+	if d.EqualsQuals["show_deleted"] != nil {
+		showDeleted = d.EqualsQuals["show_deleted"].GetBoolValue()
+	}
+
+	// List the rules of the given calendar
+	if calendarID := d.EqualsQualString("calendar_id"); calendarID != "" {
+		_, err := streamCalendarAclRules(ctx, d, service, &calendar.CalendarListEntry{Id: calendarID}, showDeleted)
+		return nil, err
+	}
+
+	// Or else, of all the calendars owned by the user, since only the owners of a calendar can list its rules
+	var calendars []*calendar.CalendarListEntry
+	if err := service.CalendarList.List().MinAccessRole("owner").MaxResults(250).Pages(ctx, func(page *calendar.CalendarList) error {
+		calendars = append(calendars, page.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range calendars {
+		more, err := streamCalendarAclRules(ctx, d, service, entry, showDeleted)
+		if err != nil {
+			// Skip the calendars which have been deleted since they were listed
+			if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+				plugin.Logger(ctx).Debug("googleworkspace_calendar_acl.listCalendarAclRules", "calendar_id", entry.Id, "api_error", err)
+				continue
+			}
+			return nil, err
+		}
+		if !more {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// streamCalendarAclRules streams the rules of the calendar, and returns false once no more rows are needed
+func streamCalendarAclRules(ctx context.Context, d *plugin.QueryData, service *calendar.Service, entry *calendar.CalendarListEntry, showDeleted bool) (bool, error) {
+	// By default, API can return maximum 250 records in a single page
+	maxResult := int64(250)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	more := true
+	resp := service.Acl.List(entry.Id).ShowDeleted(showDeleted).MaxResults(maxResult)
+	if err := resp.Pages(ctx, func(page *calendar.Acl) error {
+		for _, rule := range page.Items {
+			d.StreamListItem(ctx, calendarAclRule{CalendarId: entry.Id, CalendarSummary: entry.Summary, AclRule: *rule})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				more = false
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return more, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCalendarAclRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCalendarAclRule")
+
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/acl/get#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarScope)
+	if err != nil {
+		return nil, err
+	}
+	calendarID := d.EqualsQualString("calendar_id")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if calendarID == "" || id == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Acl.Get(calendarID, id).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarAclRule{CalendarId: calendarID, AclRule: *resp}, nil
+}
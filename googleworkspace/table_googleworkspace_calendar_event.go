@@ -143,6 +143,12 @@ func calendarEventColumns() []*plugin.Column {
 			Type:        proto.ColumnType_BOOL,
 			Default:     false,
 		},
+		{
+			Name:        "private_extended_property",
+			Description: "Filter events by a private extended property, as propertyName=value.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("private_extended_property"),
+		},
 		{
 			Name:        "query",
 			Description: "Filter string to filter events.",
@@ -159,6 +165,24 @@ func calendarEventColumns() []*plugin.Column {
 			Description: "Sequence number as per iCalendar.",
 			Type:        proto.ColumnType_INT,
 		},
+		{
+			Name:        "shared_extended_property",
+			Description: "Filter events by a shared extended property, as propertyName=value.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("shared_extended_property"),
+		},
+		{
+			Name:        "show_deleted",
+			Description: "Whether to include deleted events, with the cancelled status. Defaults to false.",
+			Type:        proto.ColumnType_BOOL,
+			Transform:   transform.FromQual("show_deleted"),
+		},
+		{
+			Name:        "single_events",
+			Description: "Whether to expand recurring events into instances and only return single one-off events and instances of recurring events, but not the underlying recurring events themselves. Defaults to true.",
+			Type:        proto.ColumnType_BOOL,
+			Transform:   transform.FromQual("single_events"),
+		},
 		{
 			Name:        "timezone",
 			Description: "The time zone of the calendar.",
@@ -242,21 +266,12 @@ func tableGoogleWorkspaceCalendarEvent(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate:           listCalendarEvents,
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{
 					Name:    "calendar_id",
 					Require: plugin.Required,
 				},
-				{
-					Name:    "query",
-					Require: plugin.Optional,
-				},
-				{
-					Name:      "start_time",
-					Require:   plugin.Optional,
-					Operators: []string{">", ">=", "=", "<", "<="},
-				},
-			},
+			}, calendarEventListKeyColumns()...),
 		},
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"calendar_id", "id"}),
@@ -287,33 +302,7 @@ func listCalendarEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		}
 	}
 
-	// Free text search terms to find events that match these terms in any field, except for extended properties
-	var query string
-	if d.EqualsQuals["query"] != nil {
-		query = d.EqualsQuals["query"].GetStringValue()
-	}
-
-	resp := service.Events.List(calendarID).ShowDeleted(false).SingleEvents(true).Q(query).MaxResults(maxResult)
-	if d.Quals["start_time"] != nil {
-		for _, q := range d.Quals["start_time"].Quals {
-			givenTime := q.Value.GetTimestampValue().AsTime()
-			beforeTime := givenTime.Add(time.Duration(-1) * time.Second).Format("2006-01-02T15:04:05.000Z")
-			afterTime := givenTime.Add(time.Second * 1).Format("2006-01-02T15:04:05.000Z")
-
-			switch q.Operator {
-			case ">":
-				resp.TimeMin(afterTime)
-			case ">=":
-				resp.TimeMin(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "=":
-				resp.TimeMin(givenTime.Format("2006-01-02T15:04:05.000Z")).TimeMax(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "<=":
-				resp.TimeMax(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "<":
-				resp.TimeMax(beforeTime)
-			}
-		}
-	}
+	resp := buildCalendarEventsListCall(d, service.Events.List(calendarID).MaxResults(maxResult))
 	if err := resp.Pages(ctx, func(page *calendar.Events) error {
 		for _, event := range page.Items {
 			d.StreamListItem(ctx, calendarEvent{*event, calendarID})
@@ -332,6 +321,127 @@ func listCalendarEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	return nil, nil
 }
 
+// calendarEventListKeyColumns returns the key columns pushed down to the events list API
+func calendarEventListKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:    "query",
+			Require: plugin.Optional,
+		},
+		{
+			Name:      "start_time",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+		{
+			Name:      "updated_at",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">="},
+		},
+		{
+			Name:    "ical_uid",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "event_type",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "private_extended_property",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "shared_extended_property",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "show_deleted",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "single_events",
+			Require: plugin.Optional,
+		},
+	}
+}
+
+// buildCalendarEventsListCall applies the quals of the query to the events list call
+func buildCalendarEventsListCall(d *plugin.QueryData, resp *calendar.EventsListCall) *calendar.EventsListCall {
+	// Recurring events are expanded into their instances, and deleted events are omitted, unless requested otherwise
+	singleEvents, showDeleted := true, false
+	if d.EqualsQuals["single_events"] != nil {
+		singleEvents = d.EqualsQuals["single_events"].GetBoolValue()
+	}
+	if d.EqualsQuals["show_deleted"] != nil {
+		showDeleted = d.EqualsQuals["show_deleted"].GetBoolValue()
+	}
+	resp.SingleEvents(singleEvents).ShowDeleted(showDeleted)
+
+	// Free text search terms to find events that match these terms in any field, except for extended properties
+	if d.EqualsQuals["query"] != nil {
+		resp.Q(d.EqualsQuals["query"].GetStringValue())
+	}
+
+	timeMin, timeMax := calendarEventTimeRange(d)
+	if timeMin != "" {
+		resp.TimeMin(timeMin)
+	}
+	if timeMax != "" {
+		resp.TimeMax(timeMax)
+	}
+
+	// Events deleted since the given time are always included, regardless of show_deleted
+	if d.Quals["updated_at"] != nil {
+		for _, q := range d.Quals["updated_at"].Quals {
+			resp.UpdatedMin(q.Value.GetTimestampValue().AsTime().Format(time.RFC3339))
+		}
+	}
+
+	if d.EqualsQuals["ical_uid"] != nil {
+		resp.ICalUID(d.EqualsQuals["ical_uid"].GetStringValue())
+	}
+	if d.EqualsQuals["event_type"] != nil {
+		resp.EventTypes(d.EqualsQuals["event_type"].GetStringValue())
+	}
+	if d.EqualsQuals["private_extended_property"] != nil {
+		resp.PrivateExtendedProperty(d.EqualsQuals["private_extended_property"].GetStringValue())
+	}
+	if d.EqualsQuals["shared_extended_property"] != nil {
+		resp.SharedExtendedProperty(d.EqualsQuals["shared_extended_property"].GetStringValue())
+	}
+
+	return resp
+}
+
+// calendarEventTimeRange returns the time bounds of the events, derived from the start_time quals
+func calendarEventTimeRange(d *plugin.QueryData) (string, string) {
+	var timeMin, timeMax string
+	if d.Quals["start_time"] == nil {
+		return timeMin, timeMax
+	}
+
+	for _, q := range d.Quals["start_time"].Quals {
+		givenTime := q.Value.GetTimestampValue().AsTime()
+		beforeTime := givenTime.Add(time.Duration(-1) * time.Second).Format("2006-01-02T15:04:05.000Z")
+		afterTime := givenTime.Add(time.Second * 1).Format("2006-01-02T15:04:05.000Z")
+
+		switch q.Operator {
+		case ">":
+			timeMin = afterTime
+		case ">=":
+			timeMin = givenTime.Format("2006-01-02T15:04:05.000Z")
+		case "=":
+			timeMin, timeMax = givenTime.Format("2006-01-02T15:04:05.000Z"), givenTime.Format("2006-01-02T15:04:05.000Z")
+		case "<=":
+			timeMax = givenTime.Format("2006-01-02T15:04:05.000Z")
+		case "<":
+			timeMax = beforeTime
+		}
+	}
+
+	return timeMin, timeMax
+}
+
 //// HYDRATE FUNCTIONS
 
 func getCalendarEvent(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
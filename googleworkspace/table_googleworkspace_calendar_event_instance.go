@@ -0,0 +1,107 @@
+package googleworkspace
+
+import (
+	"context"
+	"slices"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// Columns of the events which are only used as quals by the events list API
+var calendarEventListOnlyColumns = []string{"query", "private_extended_property", "shared_extended_property", "single_events"}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarEventInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_event_instance",
+		Description: "Instances of the specified recurring event.",
+		List: &plugin.ListConfig{
+			Hydrate:           listCalendarEventInstances,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "calendar_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "recurring_event_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:      "start_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+				{
+					Name:    "show_deleted",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: slices.DeleteFunc(calendarEventColumns(), func(column *plugin.Column) bool {
+			return slices.Contains(calendarEventListOnlyColumns, column.Name)
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarEventInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/events/instances#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	calendarID := d.EqualsQualString("calendar_id")
+	recurringEventID := d.EqualsQualString("recurring_event_id")
+
+	// Return nil, if no input provided
+	if calendarID == "" || recurringEventID == "" {
+		return nil, nil
+	}
+
+	// By default, API can return maximum 2500 records in a single page
+	maxResult := int64(2500)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	var showDeleted bool
+	if d.EqualsQuals["show_deleted"] != nil {
+		showDeleted = d.EqualsQuals["show_deleted"].GetBoolValue()
+	}
+
+	resp := service.Events.Instances(calendarID, recurringEventID).ShowDeleted(showDeleted).MaxResults(maxResult)
+	timeMin, timeMax := calendarEventTimeRange(d)
+	if timeMin != "" {
+		resp.TimeMin(timeMin)
+	}
+	if timeMax != "" {
+		resp.TimeMax(timeMax)
+	}
+
+	if err := resp.Pages(ctx, func(page *calendar.Events) error {
+		for _, event := range page.Items {
+			d.StreamListItem(ctx, calendarEvent{*event, calendarID})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,104 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarFeature(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_feature",
+		Description: "Features which can be assigned to the calendar resources, such as a whiteboard or a video conferencing system.",
+		List: &plugin.ListConfig{
+			Hydrate: listCalendarFeatures,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("name"),
+			Hydrate:           getCalendarFeature,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the feature.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etags",
+				Description: "ETag of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarFeatures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.features/list#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// By default, API can return maximum 500 records in a single page
+	maxResult := int64(500)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	resp := service.Resources.Features.List(directoryMyCustomer).MaxResults(maxResult)
+	if err := resp.Pages(ctx, func(page *directory.Features) error {
+		for _, feature := range page.Features {
+			d.StreamListItem(ctx, feature)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCalendarFeature(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCalendarFeature")
+
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.features/get#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	name := d.EqualsQualString("name")
+
+	// Return nil, if no input provided
+	if name == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Resources.Features.Get(directoryMyCustomer, name).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,219 @@
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+// The free/busy API accepts a maximum of 50 calendars or groups in a single request
+const calendarFreeBusyMaxItems = 50
+
+type calendarFreeBusyPeriod = struct {
+	CalendarId string
+	GroupId    string
+	Start      string
+	End        string
+	Errors     []*calendar.Error
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarFreeBusy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_freebusy",
+		Description: "Busy periods of a set of calendars and groups, within a given time range.",
+		List: &plugin.ListConfig{
+			Hydrate: listCalendarFreeBusy,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "calendar_ids",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "time_min",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "time_max",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "group_expansion_max",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "calendar_expansion_max",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "calendar_id",
+				Description: "Identifier of the calendar. For a group which could not be expanded, the identifier of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_id",
+				Description: "Identifier of the queried group the calendar belongs to, if the calendar was expanded from a group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start of the busy period. Null if the calendar has no busy period in the time range.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Start").NullIfZero(),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the busy period, exclusive. Null if the calendar has no busy period in the time range.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("End").NullIfZero(),
+			},
+			{
+				Name:        "errors",
+				Description: "Errors that occurred while getting the busy periods of the calendar, or while expanding the group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "calendar_ids",
+				Description: "The identifiers of the calendars and groups to query, as a JSON array.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("calendar_ids"),
+			},
+			{
+				Name:        "time_min",
+				Description: "The start of the time range to query.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("time_min"),
+			},
+			{
+				Name:        "time_max",
+				Description: "The end of the time range to query.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("time_max"),
+			},
+			{
+				Name:        "group_expansion_max",
+				Description: "Maximal number of calendar identifiers to be provided for a single group. Maximum value is 100.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("group_expansion_max"),
+			},
+			{
+				Name:        "calendar_expansion_max",
+				Description: "Maximal number of calendars for which free/busy information is to be provided. Maximum value is 50.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("calendar_expansion_max"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarFreeBusy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/freebusy/query#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	var calendarIDs []string
+	if err := json.Unmarshal([]byte(d.EqualsQuals["calendar_ids"].GetJsonbValue()), &calendarIDs); err != nil {
+		return nil, fmt.Errorf("calendar_ids must be a JSON array of calendar or group identifiers: %v", err)
+	}
+
+	// Drop the duplicates, while keeping the order of the identifiers
+	var items []*calendar.FreeBusyRequestItem
+	for i, id := range calendarIDs {
+		if id != "" && !slices.Contains(calendarIDs[:i], id) {
+			items = append(items, &calendar.FreeBusyRequestItem{Id: id})
+		}
+	}
+
+	req := &calendar.FreeBusyRequest{
+		TimeMin: d.EqualsQuals["time_min"].GetTimestampValue().AsTime().Format(time.RFC3339),
+		TimeMax: d.EqualsQuals["time_max"].GetTimestampValue().AsTime().Format(time.RFC3339),
+	}
+	if d.EqualsQuals["group_expansion_max"] != nil {
+		req.GroupExpansionMax = d.EqualsQuals["group_expansion_max"].GetInt64Value()
+	}
+	if d.EqualsQuals["calendar_expansion_max"] != nil {
+		req.CalendarExpansionMax = d.EqualsQuals["calendar_expansion_max"].GetInt64Value()
+	}
+
+	for chunk := range slices.Chunk(items, calendarFreeBusyMaxItems) {
+		req.Items = chunk
+		resp, err := service.Freebusy.Query(req).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range chunk {
+			if !streamCalendarFreeBusy(ctx, d, resp, item.Id) {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// streamCalendarFreeBusy streams the busy periods of the given calendar, or of the calendars of the given group,
+// and returns false once no more rows are needed
+func streamCalendarFreeBusy(ctx context.Context, d *plugin.QueryData, resp *calendar.FreeBusyResponse, id string) bool {
+	var rows []calendarFreeBusyPeriod
+
+	if group, ok := resp.Groups[id]; ok {
+		if len(group.Errors) > 0 {
+			rows = append(rows, calendarFreeBusyPeriod{CalendarId: id, GroupId: id, Errors: group.Errors})
+		}
+		for _, calendarID := range group.Calendars {
+			rows = append(rows, calendarFreeBusyPeriods(resp.Calendars[calendarID], calendarID, id)...)
+		}
+	} else if freeBusy, ok := resp.Calendars[id]; ok {
+		rows = calendarFreeBusyPeriods(freeBusy, id, "")
+	}
+
+	for _, row := range rows {
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// calendarFreeBusyPeriods returns a row per busy period of the calendar, or a single row with no period
+// if the calendar is free during the whole time range
+func calendarFreeBusyPeriods(freeBusy calendar.FreeBusyCalendar, calendarID, groupID string) []calendarFreeBusyPeriod {
+	if len(freeBusy.Busy) == 0 {
+		return []calendarFreeBusyPeriod{{CalendarId: calendarID, GroupId: groupID, Errors: freeBusy.Errors}}
+	}
+
+	var rows []calendarFreeBusyPeriod
+	for _, period := range freeBusy.Busy {
+		rows = append(rows, calendarFreeBusyPeriod{
+			CalendarId: calendarID,
+			GroupId:    groupID,
+			Start:      period.Start,
+			End:        period.End,
+			Errors:     freeBusy.Errors,
+		})
+	}
+
+	return rows
+}
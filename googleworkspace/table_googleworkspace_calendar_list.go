@@ -0,0 +1,234 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarList(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_list",
+		Description: "Calendars on the calendar list of the user.",
+		List: &plugin.ListConfig{
+			Hydrate: listCalendarListEntries,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "min_access_role",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "show_hidden",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "show_deleted",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("id"),
+			Hydrate:           getCalendarListEntry,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "Identifier of the calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "summary",
+				Description: "Title of the calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "summary_override",
+				Description: "The summary that the user has set for this calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the calendar.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "access_role",
+				Description: "The effective access role that the user has on the calendar. Possible values are: freeBusyReader, reader, writer and owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "primary",
+				Description: "Indicates whether the calendar is the primary calendar of the user, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Primary"),
+			},
+			{
+				Name:        "selected",
+				Description: "Indicates whether the calendar content shows up in the calendar UI, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Selected"),
+			},
+			{
+				Name:        "hidden",
+				Description: "Indicates whether the calendar has been hidden from the list, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Hidden"),
+			},
+			{
+				Name:        "deleted",
+				Description: "Indicates whether this calendar list entry has been deleted from the calendar list, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Deleted"),
+			},
+			{
+				Name:        "timezone",
+				Description: "The time zone of the calendar.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeZone"),
+			},
+			{
+				Name:        "location",
+				Description: "Geographic location of the calendar as free-form text.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "color_id",
+				Description: "The color of the calendar, as an ID referring to an entry in the calendar section of the colors definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "background_color",
+				Description: "The main color of the calendar in the hexadecimal format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "foreground_color",
+				Description: "The foreground color of the calendar in the hexadecimal format.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "ETag of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "min_access_role",
+				Description: "The minimum access role for the user in the returned calendars. Possible values are: freeBusyReader, reader, writer and owner. Defaults to no restriction.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("min_access_role"),
+			},
+			{
+				Name:        "show_hidden",
+				Description: "Whether to show hidden calendars. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("show_hidden"),
+			},
+			{
+				Name:        "show_deleted",
+				Description: "Whether to include deleted calendar list entries. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("show_deleted"),
+			},
+			{
+				Name:        "default_reminders",
+				Description: "The default reminders that the user has for this calendar.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "notification_settings",
+				Description: "The notifications that the user is receiving for this calendar.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "conference_properties",
+				Description: "Describes the conferencing properties for this calendar.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarListEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/calendarList/list#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	var showHidden, showDeleted bool
+	if d.EqualsQuals["show_hidden"] != nil {
+		showHidden = d.EqualsQuals["show_hidden"].GetBoolValue()
+	}
+	if d.EqualsQuals["show_deleted"] != nil {
+		showDeleted = d.EqualsQuals["show_deleted"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 250 records in a single page
+	maxResult := int64(250)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	resp := service.CalendarList.List().ShowHidden(showHidden).ShowDeleted(showDeleted).MaxResults(maxResult)
+	if minAccessRole := d.EqualsQualString("min_access_role"); minAccessRole != "" {
+		resp = resp.MinAccessRole(minAccessRole)
+	}
+
+	if err := resp.Pages(ctx, func(page *calendar.CalendarList) error {
+		for _, entry := range page.Items {
+			d.StreamListItem(ctx, entry)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCalendarListEntry(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCalendarListEntry")
+
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/calendarList/get#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	calendarID := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if calendarID == "" {
+		return nil, nil
+	}
+
+	resp, err := service.CalendarList.Get(calendarID).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -2,7 +2,6 @@ package googleworkspace
 
 import (
 	"context"
-	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 
@@ -18,17 +17,7 @@ func tableGoogleWorkspaceCalendarMyEvent(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate:           listCalendarMyEvents,
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			KeyColumns: []*plugin.KeyColumn{
-				{
-					Name:    "query",
-					Require: plugin.Optional,
-				},
-				{
-					Name:      "start_time",
-					Require:   plugin.Optional,
-					Operators: []string{">", ">=", "=", "<", "<="},
-				},
-			},
+			KeyColumns:        calendarEventListKeyColumns(),
 		},
 		Columns: calendarEventColumns(),
 	}
@@ -54,33 +43,7 @@ func listCalendarMyEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 		}
 	}
 
-	// Free text search terms to find events that match these terms in any field, except for extended properties
-	var query string
-	if d.EqualsQuals["query"] != nil {
-		query = d.EqualsQuals["query"].GetStringValue()
-	}
-
-	resp := service.Events.List("primary").ShowDeleted(false).SingleEvents(true).Q(query).MaxResults(maxResult)
-	if d.Quals["start_time"] != nil {
-		for _, q := range d.Quals["start_time"].Quals {
-			givenTime := q.Value.GetTimestampValue().AsTime()
-			beforeTime := givenTime.Add(time.Duration(-1) * time.Second).Format("2006-01-02T15:04:05.000Z")
-			afterTime := givenTime.Add(time.Second * 1).Format("2006-01-02T15:04:05.000Z")
-
-			switch q.Operator {
-			case ">":
-				resp.TimeMin(afterTime)
-			case ">=":
-				resp.TimeMin(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "=":
-				resp.TimeMin(givenTime.Format("2006-01-02T15:04:05.000Z")).TimeMax(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "<=":
-				resp.TimeMax(givenTime.Format("2006-01-02T15:04:05.000Z"))
-			case "<":
-				resp.TimeMax(beforeTime)
-			}
-		}
-	}
+	resp := buildCalendarEventsListCall(d, service.Events.List("primary").MaxResults(maxResult))
 	if err := resp.Pages(ctx, func(page *calendar.Events) error {
 		for _, event := range page.Items {
 			d.StreamListItem(ctx, calendarEvent{*event, page.Summary})
@@ -0,0 +1,230 @@
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	directory "google.golang.org/api/admin/directory/v1"
+)
+
+// The resources APIs identify the account of the authenticated user with this alias
+const directoryMyCustomer = "my_customer"
+
+// Values which can be used as is in the query of the calendar resources, without being mistaken for an operator,
+// a wildcard or a separator
+var calendarResourceQueryValuePattern = regexp.MustCompile(`^[A-Za-z0-9_.@+-]+$`)
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarResource(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_resource",
+		Description: "Calendar resources, such as meeting rooms, which can be booked for the events.",
+		List: &plugin.ListConfig{
+			Hydrate: listCalendarResources,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "building_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_category",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_email",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("resource_id"),
+			Hydrate:           getCalendarResource,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource_id",
+				Description: "The unique ID for the calendar resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the calendar resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_email",
+				Description: "The read-only email address for the calendar resource, which is the email address of the resource in the attendees of the events.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "generated_resource_name",
+				Description: "The read-only auto-generated name of the calendar resource, which includes metadata about the resource such as building name, floor and capacity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_category",
+				Description: "The category of the calendar resource. Possible values are: CONFERENCE_ROOM, OTHER and CATEGORY_UNKNOWN.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the calendar resource, intended for non-room calendar resources.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_description",
+				Description: "Description of the resource, visible only to admins.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_visible_description",
+				Description: "Description of the resource, visible to users and admins.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "capacity",
+				Description: "The capacity of the resource, that is the number of seats in the room.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "building_id",
+				Description: "The ID of the building the resource is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "floor_name",
+				Description: "The name of the floor the resource is located on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "floor_section",
+				Description: "The name of the section within the floor the resource is located in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etags",
+				Description: "ETag of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "features",
+				Description: "The names of the features of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FeatureInstances").Transform(calendarResourceFeatureNames),
+			},
+			{
+				Name:        "feature_instances",
+				Description: "Instances of the features of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarResources(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.calendars/list#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// By default, API can return maximum 500 records in a single page
+	maxResult := int64(500)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	resp := service.Resources.Calendars.List(directoryMyCustomer).MaxResults(maxResult)
+
+	// The query syntax has no documented quoting, so the values which would need to be quoted are not pushed down,
+	// and are only filtered by Steampipe
+	var filter []string
+	for _, column := range []string{"building_id", "resource_category", "resource_email"} {
+		if value := d.EqualsQualString(column); calendarResourceQueryValuePattern.MatchString(value) {
+			filter = append(filter, fmt.Sprintf("%s=%s", strcase.ToLowerCamel(column), value))
+		}
+	}
+	if len(filter) > 0 {
+		resp = resp.Query(strings.Join(filter, " AND "))
+	}
+
+	if err := resp.Pages(ctx, func(page *directory.CalendarResources) error {
+		for _, resource := range page.Items {
+			d.StreamListItem(ctx, resource)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCalendarResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCalendarResource")
+
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/resources.calendars/get#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryResourceCalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	resourceID := d.EqualsQualString("resource_id")
+
+	// Return nil, if no input provided
+	if resourceID == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Resources.Calendars.Get(directoryMyCustomer, resourceID).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// calendarResourceFeatureNames returns the names of the features from the feature instances of the resource
+func calendarResourceFeatureNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	instances, ok := d.Value.([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var names []string
+	for _, instance := range instances {
+		instance, _ := instance.(map[string]interface{})
+		feature, _ := instance["feature"].(map[string]interface{})
+		if name, ok := feature["name"].(string); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,342 @@
+package googleworkspace
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type calendarTeamScheduleDay = struct {
+	UserEmail  string
+	GroupEmail string
+	Date       time.Time
+	Status     string
+	Location   string
+	Event      *calendar.Event
+}
+
+// Event types used to determine the availability of a user, in order of precedence
+var calendarTeamScheduleEventTypes = []string{"outOfOffice", "workingLocation", "focusTime"}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceCalendarTeamSchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_calendar_team_schedule",
+		Description: "Daily availability of users, based on out-of-office, working location and focus time events on their primary calendars.",
+		List: &plugin.ListConfig{
+			Hydrate: listCalendarTeamSchedules,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "group_email",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:      "date",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_email",
+				Description: "The email address of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "date",
+				Description: "The day, in the time zone of the user's primary calendar.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "status",
+				Description: "The availability of the user on the day. Possible values are: outOfOffice, homeOffice, officeLocation, customLocation and focusTime. Null if no such event is scheduled.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The working location of the user on the day, if specified.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "group_email",
+				Description: "The email address of the group the user is a member of, if queried.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_id",
+				Description: "Identifier of the event the status is derived from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Event.Id"),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of the event the status is derived from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Event.EventType"),
+			},
+			{
+				Name:        "event_summary",
+				Description: "The title of the event the status is derived from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Event.Summary"),
+			},
+			{
+				Name:        "source_event",
+				Description: "The event the status is derived from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Event"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listCalendarTeamSchedules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/calendar/api/v3/reference/events/list#auth
+	service, err := CalendarServiceWithScope(ctx, d, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	groupEmail := d.EqualsQualString("group_email")
+
+	var userEmails []string
+	if groupEmail != "" {
+		userEmails, err = listGroupMemberEmails(ctx, d, groupEmail)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if userEmail := d.EqualsQualString("user_email"); userEmail != "" {
+		// Return nil, if the user is not a member of the queried group
+		if groupEmail != "" && !slices.ContainsFunc(userEmails, func(email string) bool { return strings.EqualFold(email, userEmail) }) {
+			return nil, nil
+		}
+		userEmails = []string{userEmail}
+	}
+
+	// By default, return the schedule for the next 7 days
+	startDay := truncateToDay(time.Now().UTC())
+	endDay := startDay.AddDate(0, 0, 6)
+	if d.Quals["date"] != nil {
+		for _, q := range d.Quals["date"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+			givenDay := truncateToDay(givenTime)
+
+			switch q.Operator {
+			case ">":
+				startDay = givenDay.AddDate(0, 0, 1)
+			case ">=":
+				startDay = givenDay
+				if givenTime.After(givenDay) {
+					startDay = givenDay.AddDate(0, 0, 1)
+				}
+			case "=":
+				startDay, endDay = givenDay, givenDay
+			case "<=":
+				endDay = givenDay
+			case "<":
+				endDay = givenDay
+				if givenTime.Equal(givenDay) {
+					endDay = givenDay.AddDate(0, 0, -1)
+				}
+			}
+		}
+	}
+
+	// Return nil, if the requested range is empty
+	if endDay.Before(startDay) {
+		return nil, nil
+	}
+
+	for _, userEmail := range userEmails {
+		events, timeZone, err := listCalendarTeamScheduleEvents(ctx, service, userEmail, startDay, endDay)
+		if err != nil {
+			// Skip users whose calendar is not accessible to the authenticated user
+			if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 403 || gerr.Code == 404) {
+				plugin.Logger(ctx).Warn("googleworkspace_calendar_team_schedule.listCalendarTeamSchedules", "user_email", userEmail, "api_error", err)
+				continue
+			}
+			return nil, err
+		}
+
+		for day := startDay; !day.After(endDay); day = day.AddDate(0, 0, 1) {
+			row := calendarTeamScheduleDay{
+				UserEmail:  userEmail,
+				GroupEmail: groupEmail,
+				Date:       day,
+			}
+
+			if event := selectCalendarTeamScheduleEvent(events, day, timeZone); event != nil {
+				row.Event = event
+				row.Status, row.Location = calendarTeamScheduleStatus(event)
+			}
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listCalendarTeamScheduleEvents returns the availability related events on the user's primary calendar,
+// along with the time zone of the calendar
+func listCalendarTeamScheduleEvents(ctx context.Context, service *calendar.Service, userEmail string, startDay, endDay time.Time) ([]*calendar.Event, *time.Location, error) {
+	var events []*calendar.Event
+	timeZone := time.UTC
+
+	// Widen the window by a day on both sides, since the days are bucketed in the calendar's time zone
+	timeMin := startDay.AddDate(0, 0, -1).Format(time.RFC3339)
+	timeMax := endDay.AddDate(0, 0, 2).Format(time.RFC3339)
+
+	resp := service.Events.List(userEmail).EventTypes(calendarTeamScheduleEventTypes...).ShowDeleted(false).SingleEvents(true).TimeMin(timeMin).TimeMax(timeMax).MaxResults(2500)
+	if err := resp.Pages(ctx, func(page *calendar.Events) error {
+		if page.TimeZone != "" {
+			if location, err := time.LoadLocation(page.TimeZone); err == nil {
+				timeZone = location
+			}
+		}
+		for _, event := range page.Items {
+			if event.Status == "cancelled" {
+				continue
+			}
+			events = append(events, event)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return events, timeZone, nil
+}
+
+// listGroupMemberEmails returns the email addresses of all users who are members of the given group,
+// including members of nested groups
+func listGroupMemberEmails(ctx context.Context, d *plugin.QueryData, groupEmail string) ([]string, error) {
+	// Create service
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/members/list#authorization-scopes
+	service, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryGroupMemberReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	var userEmails []string
+	resp := service.Members.List(groupEmail).IncludeDerivedMembership(true).MaxResults(200)
+	if err := resp.Pages(ctx, func(page *directory.Members) error {
+		for _, member := range page.Members {
+			if member.Type == "USER" && member.Email != "" {
+				userEmails = append(userEmails, member.Email)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return userEmails, nil
+}
+
+// selectCalendarTeamScheduleEvent returns the event with the highest precedence which covers the given day
+func selectCalendarTeamScheduleEvent(events []*calendar.Event, day time.Time, timeZone *time.Location) *calendar.Event {
+	var selected *calendar.Event
+	selectedRank := len(calendarTeamScheduleEventTypes)
+
+	for _, event := range events {
+		startDay, endDay, ok := calendarEventDays(event, timeZone)
+		if !ok || day.Before(startDay) || day.After(endDay) {
+			continue
+		}
+		for rank, eventType := range calendarTeamScheduleEventTypes {
+			if event.EventType == eventType && rank < selectedRank {
+				selected, selectedRank = event, rank
+			}
+		}
+	}
+
+	return selected
+}
+
+// calendarEventDays returns the first and the last day covered by the event, in the given time zone
+func calendarEventDays(event *calendar.Event, timeZone *time.Location) (time.Time, time.Time, bool) {
+	if event.Start == nil || event.End == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// All-day events only include the date, and the end date is exclusive
+	if event.Start.Date != "" {
+		start, err := time.Parse("2006-01-02", event.Start.Date)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		end, err := time.Parse("2006-01-02", event.End.Date)
+		if err != nil {
+			return start, start, true
+		}
+		return start, end.AddDate(0, 0, -1), true
+	}
+
+	start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil || !end.After(start) {
+		end = start.Add(time.Nanosecond)
+	}
+
+	// The end time is exclusive
+	return truncateToDay(start.In(timeZone)), truncateToDay(end.Add(-time.Nanosecond).In(timeZone)), true
+}
+
+// calendarTeamScheduleStatus returns the status and the location derived from the given event
+func calendarTeamScheduleStatus(event *calendar.Event) (string, string) {
+	if event.EventType != "workingLocation" {
+		return event.EventType, ""
+	}
+
+	properties := event.WorkingLocationProperties
+	if properties == nil {
+		return event.EventType, event.Location
+	}
+
+	switch {
+	case properties.OfficeLocation != nil:
+		location := properties.OfficeLocation.Label
+		if location == "" {
+			location = properties.OfficeLocation.BuildingId
+		}
+		return "officeLocation", location
+	case properties.CustomLocation != nil:
+		return "customLocation", properties.CustomLocation.Label
+	case properties.HomeOffice != nil || properties.Type == "homeOffice":
+		return "homeOffice", "Home"
+	}
+
+	return properties.Type, event.Location
+}
+
+// truncateToDay returns the date of the given time, as midnight UTC
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,155 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+)
+
+type driveAbout = struct {
+	UserEmail string
+	drive.About
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveAbout(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_about",
+		Description: "Storage quota, capabilities and supported formats of the Google Drive of a user.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveAbout,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "user_email",
+				Description: "The email of the user. Set it in the where clause to impersonate another user, which requires domain-wide delegation.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.DisplayName"),
+			},
+			{
+				Name:        "permission_id",
+				Description: "The ID of the user in the permissions of the files.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.PermissionId"),
+			},
+			{
+				Name:        "storage_quota_limit",
+				Description: "The usage limit of the storage quota in bytes. Not populated if the user has unlimited storage.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StorageQuota.Limit").NullIfZero(),
+			},
+			{
+				Name:        "storage_quota_usage",
+				Description: "The total usage of the storage quota in bytes, across all the Google services.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StorageQuota.Usage"),
+			},
+			{
+				Name:        "storage_quota_usage_in_drive",
+				Description: "The usage of the storage quota by the files in the Google Drive in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StorageQuota.UsageInDrive"),
+			},
+			{
+				Name:        "storage_quota_usage_in_drive_trash",
+				Description: "The usage of the storage quota by the trashed files in the Google Drive in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StorageQuota.UsageInDriveTrash"),
+			},
+			{
+				Name:        "max_upload_size",
+				Description: "The maximum upload size in bytes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "can_create_drives",
+				Description: "Indicates whether the user can create shared drives, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CanCreateDrives"),
+			},
+			{
+				Name:        "app_installed",
+				Description: "Indicates whether the user has installed the requesting app, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AppInstalled"),
+			},
+			{
+				Name:        "user",
+				Description: "The authenticated user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "storage_quota",
+				Description: "The storage quota limits and usage of the user, in bytes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "import_formats",
+				Description: "A map of the source MIME types to the Google Workspace types they can be imported to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "export_formats",
+				Description: "A map of the Google Workspace MIME types to the MIME types they can be exported to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "max_import_sizes",
+				Description: "A map of the MIME types to the maximum size in bytes of the files which can be imported to them.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "drive_themes",
+				Description: "The themes which can be set for the shared drives.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "folder_color_palette",
+				Description: "The colors which can be set for the folders, as RGB hex strings.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveAbout(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/about/get#authorization-scopes
+	userEmail := d.EqualsQualString("user_email")
+	service, err := DriveServiceForUser(ctx, d, userEmail, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fields parameter is required by the about API
+	resp, err := service.About.Get().Fields("*").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the email given in the query, so that the row matches it regardless of its case
+	if userEmail == "" && resp.User != nil {
+		userEmail = resp.User.EmailAddress
+	}
+	d.StreamListItem(ctx, driveAbout{UserEmail: userEmail, About: *resp})
+
+	return nil, nil
+}
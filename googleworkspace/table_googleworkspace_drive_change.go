@@ -0,0 +1,198 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-sdk/v5/query_cache"
+
+	"google.golang.org/api/drive/v3"
+)
+
+type driveChange = struct {
+	drive.Change
+	PageToken         string
+	NewStartPageToken string
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_change",
+		Description: "Changes to the files and shared drives of the user, from the Drive change log.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveChanges,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:       "page_token",
+					Require:    plugin.Optional,
+					CacheMatch: query_cache.CacheMatchExact,
+				},
+				{
+					Name:    "drive_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "include_removed",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "restrict_to_my_drive",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "change_type",
+				Description: "The type of the change. Possible values are: file and drive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time",
+				Description: "The time of this change.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "removed",
+				Description: "Indicates whether the file or shared drive has been removed from this list of changes, for example by deletion or loss of access, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Removed"),
+			},
+			{
+				Name:        "file_id",
+				Description: "The ID of the file which has changed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_name",
+				Description: "The name of the file which has changed, if it has not been removed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.Name"),
+			},
+			{
+				Name:        "mime_type",
+				Description: "The MIME type of the file which has changed, if it has not been removed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.MimeType"),
+			},
+			{
+				Name:        "drive_id",
+				Description: "The ID of the shared drive associated with this change.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "page_token",
+				Description: "The token from which the changes are listed. Defaults to the current start page token, so that only the changes made while listing are returned. Use the googleworkspace_drive_start_page_token table to get the start page token of the first sync.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "new_start_page_token",
+				Description: "The starting page token for future changes. Only populated for the changes of the last page.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "include_removed",
+				Description: "Whether to include changes indicating that items have been removed from the list of changes. Defaults to true.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_removed"),
+			},
+			{
+				Name:        "restrict_to_my_drive",
+				Description: "Whether to restrict the results to changes inside the My Drive hierarchy. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("restrict_to_my_drive"),
+			},
+			{
+				Name:        "file",
+				Description: "The updated state of the file. Present if the type is file and the file has not been removed from this list of changes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "drive",
+				Description: "The updated state of the shared drive. Present if the change type is drive, the user is still a member of the shared drive, and the shared drive has not been deleted.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/changes/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	driveID := d.EqualsQualString("drive_id")
+
+	// Default to the current start page token
+	pageToken := d.EqualsQualString("page_token")
+	if pageToken == "" {
+		call := service.Changes.GetStartPageToken().SupportsAllDrives(true)
+		if driveID != "" {
+			call = call.DriveId(driveID)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, err
+		}
+		pageToken = resp.StartPageToken
+	}
+
+	includeRemoved := true
+	if d.EqualsQuals["include_removed"] != nil {
+		includeRemoved = d.EqualsQuals["include_removed"].GetBoolValue()
+	}
+
+	var restrictToMyDrive bool
+	if d.EqualsQuals["restrict_to_my_drive"] != nil {
+		restrictToMyDrive = d.EqualsQuals["restrict_to_my_drive"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 1000 records in a single page
+	pageSize := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	// The changes API has no Pages helper, since the page token is a required parameter
+	nextPageToken := pageToken
+	for nextPageToken != "" {
+		call := service.Changes.List(nextPageToken).Fields("nextPageToken, newStartPageToken, changes(*)").
+			IncludeRemoved(includeRemoved).RestrictToMyDrive(restrictToMyDrive).
+			IncludeItemsFromAllDrives(true).SupportsAllDrives(true).PageSize(pageSize)
+		if driveID != "" {
+			call = call.DriveId(driveID)
+		}
+		page, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range page.Changes {
+			// Changes of the files of a shared drive may not include its ID
+			if change.DriveId == "" {
+				change.DriveId = driveID
+			}
+			d.StreamListItem(ctx, driveChange{Change: *change, PageToken: pageToken, NewStartPageToken: page.NewStartPageToken})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		nextPageToken = page.NextPageToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,223 @@
+package googleworkspace
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type driveComment = struct {
+	FileId string
+	drive.Comment
+}
+
+// The comment fields, excluding the replies which are listed by the googleworkspace_drive_comment_reply table
+const driveCommentFields = "id, anchor, author, content, createdTime, deleted, htmlContent, modifiedTime, quotedFileContent, resolved"
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveComment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_comment",
+		Description: "Comments on a file in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveComments,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "file_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "include_deleted",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "modified_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">="},
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"file_id", "id"}),
+			Hydrate:    getDriveComment,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the comment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content",
+				Description: "The plain text content of the comment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "html_content",
+				Description: "The content of the comment with HTML formatting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author_email",
+				Description: "The email address of the author of the comment, if available.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.EmailAddress"),
+			},
+			{
+				Name:        "author_display_name",
+				Description: "The display name of the author of the comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.DisplayName"),
+			},
+			{
+				Name:        "resolved",
+				Description: "Indicates whether the comment has been resolved by one of its replies, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Resolved"),
+			},
+			{
+				Name:        "deleted",
+				Description: "Indicates whether the comment has been deleted, or not. A deleted comment has no content.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Deleted"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time at which the comment was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified_time",
+				Description: "The last time the comment or any of its replies was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "quoted_file_content",
+				Description: "The content of the file the comment refers to, typically within the anchor region.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotedFileContent.Value"),
+			},
+			{
+				Name:        "quoted_file_content_mime_type",
+				Description: "The MIME type of the quoted content.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("QuotedFileContent.MimeType"),
+			},
+			{
+				Name:        "anchor",
+				Description: "A region of the document represented as a JSON string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "include_deleted",
+				Description: "Whether to include deleted comments. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_deleted"),
+			},
+			{
+				Name:        "author",
+				Description: "The author of the comment.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveComments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/comments/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+
+	// Return nil, if no input provided
+	if fileID == "" {
+		return nil, nil
+	}
+
+	var includeDeleted bool
+	if d.EqualsQuals["include_deleted"] != nil {
+		includeDeleted = d.EqualsQuals["include_deleted"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 100 records in a single page
+	pageSize := int64(100)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	// The fields parameter is required by the comments API
+	resp := service.Comments.List(fileID).Fields(googleapi.Field("nextPageToken, comments(" + driveCommentFields + ")")).IncludeDeleted(includeDeleted).PageSize(pageSize)
+
+	// Only return comments modified after the given time
+	if d.Quals["modified_time"] != nil {
+		for _, q := range d.Quals["modified_time"].Quals {
+			resp = resp.StartModifiedTime(q.Value.GetTimestampValue().AsTime().Format(time.RFC3339))
+		}
+	}
+
+	if err := resp.Pages(ctx, func(page *drive.CommentList) error {
+		for _, comment := range page.Comments {
+			d.StreamListItem(ctx, driveComment{FileId: fileID, Comment: *comment})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveComment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveComment")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/comments/get#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if fileID == "" || id == "" {
+		return nil, nil
+	}
+
+	// Include deleted comments, so that they can be queried by ID
+	resp, err := service.Comments.Get(fileID, id).Fields(googleapi.Field(driveCommentFields)).IncludeDeleted(true).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return driveComment{FileId: fileID, Comment: *resp}, nil
+}
@@ -0,0 +1,200 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type driveCommentReply = struct {
+	FileId    string
+	CommentId string
+	drive.Reply
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveCommentReply(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_comment_reply",
+		Description: "Replies to a comment on a file in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveCommentReplies,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "file_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "comment_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "include_deleted",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"file_id", "comment_id", "id"}),
+			Hydrate:    getDriveCommentReply,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "comment_id",
+				Description: "The ID of the comment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the reply.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content",
+				Description: "The plain text content of the reply.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "html_content",
+				Description: "The content of the reply with HTML formatting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "action",
+				Description: "The action the reply performed to the parent comment. Possible values are: resolve and reopen.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "author_email",
+				Description: "The email address of the author of the reply, if available.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.EmailAddress"),
+			},
+			{
+				Name:        "author_display_name",
+				Description: "The display name of the author of the reply.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Author.DisplayName"),
+			},
+			{
+				Name:        "deleted",
+				Description: "Indicates whether the reply has been deleted, or not. A deleted reply has no content.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Deleted"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time at which the reply was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "modified_time",
+				Description: "The last time the reply was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "include_deleted",
+				Description: "Whether to include deleted replies. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_deleted"),
+			},
+			{
+				Name:        "author",
+				Description: "The author of the reply.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveCommentReplies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/replies/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+	commentID := d.EqualsQualString("comment_id")
+
+	// Return nil, if no input provided
+	if fileID == "" || commentID == "" {
+		return nil, nil
+	}
+
+	var includeDeleted bool
+	if d.EqualsQuals["include_deleted"] != nil {
+		includeDeleted = d.EqualsQuals["include_deleted"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 100 records in a single page
+	pageSize := int64(100)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	// The fields parameter is required by the replies API
+	resp := service.Replies.List(fileID, commentID).Fields(googleapi.Field("nextPageToken, replies(*)")).IncludeDeleted(includeDeleted).PageSize(pageSize)
+	if err := resp.Pages(ctx, func(page *drive.ReplyList) error {
+		for _, reply := range page.Replies {
+			d.StreamListItem(ctx, driveCommentReply{FileId: fileID, CommentId: commentID, Reply: *reply})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveCommentReply(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveCommentReply")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/replies/get#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+	commentID := d.EqualsQualString("comment_id")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if fileID == "" || commentID == "" || id == "" {
+		return nil, nil
+	}
+
+	// Include deleted replies, so that they can be queried by ID
+	resp, err := service.Replies.Get(fileID, commentID, id).Fields(googleapi.Field("*")).IncludeDeleted(true).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return driveCommentReply{FileId: fileID, CommentId: commentID, Reply: *resp}, nil
+}
@@ -0,0 +1,398 @@
+package googleworkspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	directory "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type driveExternalShare = struct {
+	File            *drive.File
+	Permission      *drive.Permission
+	Principal       string
+	PrincipalDomain string
+	InternalDomains []string
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveExternalShare(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_external_share",
+		Description: "Drive files shared publicly or outside the domain, with one row per external permission.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveExternalShares,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "internal_domains",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "link_sharing",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "principal_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "query",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "drive_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "corpora",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.Id"),
+			},
+			{
+				Name:        "file_name",
+				Description: "The name of the file.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.Name"),
+			},
+			{
+				Name:        "principal",
+				Description: "The external principal the file is shared with: the email address of a user or group, a domain, or anyone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_domain",
+				Description: "The domain of the external principal, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of the external principal. Possible values are: user, group, domain and anyone.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Type"),
+			},
+			{
+				Name:        "role",
+				Description: "The role granted to the external principal. Possible values are: owner, organizer, fileOrganizer, writer, commenter and reader.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Role"),
+			},
+			{
+				Name:        "link_sharing",
+				Description: "Indicates whether the file is shared with anyone who has the link, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Permission.Type").Transform(driveExternalShareLinkSharing),
+			},
+			{
+				Name:        "allow_file_discovery",
+				Description: "Indicates whether the file can be discovered through search by the external principal, or not. Only applicable to domain and anyone principals.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Permission.AllowFileDiscovery"),
+			},
+			{
+				Name:        "owner_email",
+				Description: "The email address of the owner of the file. Not populated for files in shared drives.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.Owners").Transform(driveFileOwnerEmail),
+			},
+			{
+				Name:        "permission_id",
+				Description: "The ID of the permission granting access to the external principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Id"),
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time at which the permission will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Permission.ExpirationTime").NullIfZero(),
+			},
+			{
+				Name:        "mime_type",
+				Description: "The MIME type of the file.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.MimeType"),
+			},
+			{
+				Name:        "drive_id",
+				Description: "ID of the shared drive the file resides in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.DriveId").NullIfZero(),
+			},
+			{
+				Name:        "modified_time",
+				Description: "The last time the file was modified by anyone.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("File.ModifiedTime").NullIfZero(),
+			},
+			{
+				Name:        "web_view_link",
+				Description: "A link for opening the file in a relevant Google editor or viewer in a browser.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("File.WebViewLink").NullIfZero(),
+			},
+			{
+				Name:        "internal_domains",
+				Description: "The domains considered internal, as a JSON array. Defaults to the domains and domain aliases of the customer, or to the domain of the user listing the files if the domains cannot be listed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "query",
+				Description: "A search query combining one or more search terms to [filter](https://developers.google.com/drive/api/v3/search-files) the files.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "corpora",
+				Description: "The bodies of items (files/documents) to search. Possible values are: user, drive, domain and allDrives. Defaults to drive if drive_id is specified, or else user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("corpora"),
+			},
+			{
+				Name:        "user_email",
+				Description: "The email of the user to impersonate to list the files. Defaults to the impersonated_user_email of the connection. Requires domain-wide delegation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_email"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveExternalShares(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/list#authorization-scopes
+	service, err := DriveServiceForUser(ctx, d, d.EqualsQualString("user_email"), drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	internalDomains, err := driveExternalShareInternalDomains(ctx, d, service)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := buildDriveFilesListCall(d, service)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exclude trashed files, unless a query is given
+	query := "trashed = false"
+	if q := d.EqualsQualString("query"); q != "" {
+		query = q
+	}
+
+	// Files shared with anyone have a visibility other than limited
+	if d.EqualsQuals["link_sharing"].GetBoolValue() || d.EqualsQualString("principal_type") == "anyone" {
+		query = fmt.Sprintf("(%s) and visibility != 'limited'", query)
+	}
+
+	// By default, API can return maximum 1000 records in a single page
+	maxResult := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	// The permissions inherited from a shared drive are the same for all its files, so they are listed once per drive
+	drivePermissions := map[string][]*drive.Permission{}
+
+	resp = resp.Fields("nextPageToken, files(id, name, mimeType, driveId, owners, shared, hasAugmentedPermissions, modifiedTime, webViewLink, permissions)").Q(query).PageSize(maxResult)
+	if err := resp.Pages(ctx, func(page *drive.FileList) error {
+		for _, file := range page.Files {
+			// Only the files which are shared can have external permissions
+			if !file.Shared && file.DriveId == "" {
+				continue
+			}
+
+			// Permissions are not populated for items in shared drives, nor for files the user cannot share
+			permissions := file.Permissions
+			if len(permissions) == 0 && file.DriveId != "" && !file.HasAugmentedPermissions {
+				if _, ok := drivePermissions[file.DriveId]; !ok {
+					var err error
+					drivePermissions[file.DriveId], err = listDriveFilePermissions(ctx, service, file.DriveId)
+					if err != nil {
+						// Fall back to the permissions of each file if the members of the drive cannot be listed
+						if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 403 || gerr.Code == 404) {
+							plugin.Logger(ctx).Debug("googleworkspace_drive_external_share.listDriveExternalShares", "drive_id", file.DriveId, "skipped", err)
+						} else {
+							return err
+						}
+					}
+				}
+				permissions = drivePermissions[file.DriveId]
+			}
+			if len(permissions) == 0 {
+				var err error
+				permissions, err = listDriveFilePermissions(ctx, service, file.Id)
+				if err != nil {
+					if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 403 || gerr.Code == 404) {
+						plugin.Logger(ctx).Debug("googleworkspace_drive_external_share.listDriveExternalShares", "file_id", file.Id, "skipped", err)
+						continue
+					}
+					return err
+				}
+			}
+
+			for _, permission := range permissions {
+				share, external := externalDriveShare(file, permission, internalDomains)
+				if !external {
+					continue
+				}
+				d.StreamListItem(ctx, share)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// driveExternalShareInternalDomains returns the domains considered internal: the internal_domains qual if given, or else
+// the domains of the customer, or else the domain of the user listing the files
+func driveExternalShareInternalDomains(ctx context.Context, d *plugin.QueryData, service *drive.Service) ([]string, error) {
+	if d.EqualsQuals["internal_domains"] != nil {
+		var internalDomains []string
+		if err := json.Unmarshal([]byte(d.EqualsQuals["internal_domains"].GetJsonbValue()), &internalDomains); err != nil {
+			return nil, fmt.Errorf("internal_domains must be a JSON array of domain names: %v", err)
+		}
+		return internalDomains, nil
+	}
+
+	// https://developers.google.com/workspace/admin/directory/reference/rest/v1/domains/list#authorization-scopes
+	directoryService, err := DirectoryServiceWithScope(ctx, d, directory.AdminDirectoryDomainReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The domains cannot be listed without the domain scope, or by users who are not administrators
+	resp, err := directoryService.Domains.List(directoryMyCustomer).Fields("domains(domainName, domainAliases(domainAliasName))").Do()
+	if err != nil {
+		plugin.Logger(ctx).Warn("googleworkspace_drive_external_share.driveExternalShareInternalDomains", "domains_unavailable", err)
+	} else {
+		var internalDomains []string
+		for _, domain := range resp.Domains {
+			internalDomains = append(internalDomains, strings.ToLower(domain.DomainName))
+			for _, alias := range domain.DomainAliases {
+				internalDomains = append(internalDomains, strings.ToLower(alias.DomainAliasName))
+			}
+		}
+		if len(internalDomains) > 0 {
+			return internalDomains, nil
+		}
+	}
+
+	// Default to the domain of the user listing the files
+	about, err := service.About.Get().Fields("user(emailAddress)").Do()
+	if err != nil {
+		return nil, err
+	}
+	if about.User == nil {
+		return nil, nil
+	}
+	return []string{emailDomain(about.User.EmailAddress)}, nil
+}
+
+// listDriveFilePermissions returns all the permissions of the given file, or of the given shared drive
+func listDriveFilePermissions(ctx context.Context, service *drive.Service, fileID string) ([]*drive.Permission, error) {
+	var permissions []*drive.Permission
+	err := service.Permissions.List(fileID).Fields("nextPageToken, permissions(*)").SupportsAllDrives(true).PageSize(100).Pages(ctx, func(page *drive.PermissionList) error {
+		permissions = append(permissions, page.Permissions...)
+		return nil
+	})
+	return permissions, err
+}
+
+// externalDriveShare returns the share of the file for the given permission, and whether it grants access outside the internal domains
+func externalDriveShare(file *drive.File, permission *drive.Permission, internalDomains []string) (driveExternalShare, bool) {
+	share := driveExternalShare{
+		File:            file,
+		Permission:      permission,
+		InternalDomains: internalDomains,
+	}
+	if permission == nil {
+		return share, false
+	}
+
+	switch permission.Type {
+	case "anyone":
+		share.Principal = "anyone"
+	case "domain":
+		share.Principal = permission.Domain
+		share.PrincipalDomain = strings.ToLower(permission.Domain)
+	case "user", "group":
+		share.Principal = permission.EmailAddress
+		share.PrincipalDomain = emailDomain(permission.EmailAddress)
+	default:
+		return share, false
+	}
+
+	return share, permission.Type == "anyone" || !slices.ContainsFunc(internalDomains, func(domain string) bool {
+		return strings.EqualFold(domain, share.PrincipalDomain)
+	})
+}
+
+// emailDomain returns the lowercase domain of the given email address
+func emailDomain(email string) string {
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return strings.ToLower(email[i+1:])
+	}
+	return ""
+}
+
+//// TRANSFORM FUNCTIONS
+
+func driveExternalShareLinkSharing(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permissionType, _ := d.Value.(string)
+	return permissionType == "anyone", nil
+}
+
+func driveFileOwnerEmail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	owners, ok := d.Value.([]*drive.User)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, owner := range owners {
+		if owner != nil && owner.EmailAddress != "" {
+			return owner.EmailAddress, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,199 @@
+package googleworkspace
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveFile(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_file",
+		Description: "Retrieves file's metadata from any user's corpus, shared drive or the domain.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveFiles,
+			KeyColumns: append(driveFileQueryKeyColumns(), []*plugin.KeyColumn{
+				{
+					Name:    "drive_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "corpora",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "include_items_from_all_drives",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "supports_all_drives",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			}...),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			},
+			Hydrate: getDriveFile,
+		},
+		Columns: append(driveFileColumns(), []*plugin.Column{
+			{
+				Name:        "corpora",
+				Description: "The bodies of items (files/documents) to which the query applies. Possible values are: user, drive, domain and allDrives. Defaults to drive if drive_id is specified, or else user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("corpora"),
+			},
+			{
+				Name:        "include_items_from_all_drives",
+				Description: "Whether both My Drive and shared drive items should be included in results. Defaults to true if corpora is drive, domain or allDrives.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("include_items_from_all_drives"),
+			},
+			{
+				Name:        "supports_all_drives",
+				Description: "Whether the requesting application supports both My Drives and shared drives. Defaults to true.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("supports_all_drives"),
+			},
+			{
+				Name:        "user_email",
+				Description: "The email of the user to impersonate to list the files. Defaults to the impersonated_user_email of the connection. Requires domain-wide delegation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_email"),
+			},
+		}...),
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveFiles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/list#authorization-scopes
+	service, err := DriveServiceForUser(ctx, d, d.EqualsQualString("user_email"), drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	query := buildDriveFileQuery(d)
+
+	resp, err := buildDriveFilesListCall(d, service)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for query context and requests only for queried columns
+	givenColumns := d.QueryContext.Columns
+	requiredFields := buildDriveFileRequestFields(ctx, givenColumns)
+
+	// By default, API can return maximum 1000 records in a single page
+	maxResult := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResult {
+			maxResult = *limit
+		}
+	}
+
+	resp = resp.Fields(requiredFields...).Q(query).PageSize(maxResult)
+	if err := resp.Pages(ctx, func(page *drive.FileList) error {
+		for _, file := range page.Files {
+			parsedTime, _ := time.Parse(time.RFC3339, file.CreatedTime)
+			file.CreatedTime = parsedTime.Format(time.RFC3339)
+			d.StreamListItem(ctx, file)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveFile(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveFile")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/get#authorization-scopes
+	service, err := DriveServiceForUser(ctx, d, d.EqualsQualString("user_email"), drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if fileID == "" {
+		return nil, nil
+	}
+
+	// Use "*" to return all fields
+	resp, err := service.Files.Get(fileID).Fields(googleapi.Field("*")).SupportsAllDrives(true).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// buildDriveFilesListCall builds the files.list call for the corpora, the shared drive and the options given in the query
+func buildDriveFilesListCall(d *plugin.QueryData, service *drive.Service) (*drive.FilesListCall, error) {
+	driveID := d.EqualsQualString("drive_id")
+	corpora := d.EqualsQualString("corpora")
+	if corpora == "" && driveID != "" {
+		corpora = "drive"
+	}
+	if corpora == "drive" && driveID == "" {
+		return nil, errors.New("drive_id must be specified when corpora is drive")
+	}
+
+	// Shared drive items can only be listed with includeItemsFromAllDrives, so enable it by default for corpora spanning shared drives
+	includeItemsFromAllDrives := corpora != "" && corpora != "user"
+	if d.EqualsQuals["include_items_from_all_drives"] != nil {
+		includeItemsFromAllDrives = d.EqualsQuals["include_items_from_all_drives"].GetBoolValue()
+	}
+
+	supportsAllDrives := true
+	if d.EqualsQuals["supports_all_drives"] != nil {
+		supportsAllDrives = d.EqualsQuals["supports_all_drives"].GetBoolValue()
+	}
+
+	call := service.Files.List().IncludeItemsFromAllDrives(includeItemsFromAllDrives).SupportsAllDrives(supportsAllDrives)
+	if corpora != "" {
+		call = call.Corpora(corpora)
+	}
+	if driveID != "" {
+		call = call.DriveId(driveID)
+	}
+
+	return call, nil
+}
@@ -0,0 +1,287 @@
+package googleworkspace
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/sheets/v4"
+)
+
+// The default maximum size of the content returned for each file or sheet, which is also the limit of files.export
+const defaultDriveContentMaxSize = 10 << 20
+
+// MIME types of the files with binary content, which are downloaded as text in addition to text/*
+var driveTextMimeTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/javascript",
+	"application/x-javascript",
+	"application/x-yaml",
+	"application/x-sh",
+	"application/sql",
+	"application/csv",
+}
+
+type driveFileContent = struct {
+	FileId       string
+	FileMimeType string
+	Part         string
+	MimeType     string
+}
+
+type driveContent = struct {
+	Content   string
+	Truncated bool
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveFileContent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_file_content",
+		Description: "Text content of Google Docs, Sheets and Slides, and of text files in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveFileContents,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "file_id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "part",
+				Description: "The title of the sheet, for spreadsheets which have a row per sheet.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content",
+				Description: "The text content of the file, or of the sheet as CSV.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getDriveFileContent,
+				Transform:   transform.FromField("Content"),
+			},
+			{
+				Name:        "mime_type",
+				Description: "The MIME type of the content. Possible values are: text/plain and text/csv for Google-native files, or the MIME type of the file for text files.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "file_mime_type",
+				Description: "The MIME type of the file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "truncated",
+				Description: "Indicates whether the content has been truncated to the drive_content_max_size of the connection, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getDriveFileContent,
+				Transform:   transform.FromField("Truncated"),
+			},
+			{
+				Name:        "user_email",
+				Description: "The email of the user to impersonate to read the file. Defaults to the impersonated_user_email of the connection. Requires domain-wide delegation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_email"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveFileContents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/get#authorization-scopes
+	userEmail := d.EqualsQualString("user_email")
+	service, err := DriveServiceForUser(ctx, d, userEmail, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+
+	// Return nil, if no input provided
+	if fileID == "" {
+		return nil, nil
+	}
+
+	file, err := service.Files.Get(fileID).Fields("id, mimeType").SupportsAllDrives(true).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var contents []driveFileContent
+	switch {
+	case file.MimeType == "application/vnd.google-apps.spreadsheet":
+		contents, err = listSpreadsheetContents(ctx, d, userEmail, file)
+		if err != nil {
+			return nil, err
+		}
+	case file.MimeType == "application/vnd.google-apps.document", file.MimeType == "application/vnd.google-apps.presentation":
+		contents = append(contents, driveFileContent{FileId: fileID, FileMimeType: file.MimeType, MimeType: "text/plain"})
+	case strings.HasPrefix(file.MimeType, "text/"), slices.Contains(driveTextMimeTypes, file.MimeType):
+		contents = append(contents, driveFileContent{FileId: fileID, FileMimeType: file.MimeType, MimeType: file.MimeType})
+	default:
+		plugin.Logger(ctx).Debug("googleworkspace_drive_file_content.listDriveFileContents", "file_id", fileID, "unsupported_mime_type", file.MimeType)
+		return nil, nil
+	}
+
+	for _, content := range contents {
+		d.StreamListItem(ctx, content)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveFileContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveFileContent")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/export#authorization-scopes
+	userEmail := d.EqualsQualString("user_email")
+	service, err := DriveServiceForUser(ctx, d, userEmail, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	file := h.Item.(driveFileContent)
+
+	maxSize := defaultDriveContentMaxSize
+	config := GetConfig(d.Connection)
+	if config.DriveContentMaxSize != nil && *config.DriveContentMaxSize > 0 {
+		maxSize = *config.DriveContentMaxSize
+	}
+
+	var resp *http.Response
+	switch file.FileMimeType {
+	case "application/vnd.google-apps.spreadsheet":
+		return getSheetContent(ctx, d, userEmail, file, maxSize)
+	case "application/vnd.google-apps.document", "application/vnd.google-apps.presentation":
+		resp, err = service.Files.Export(file.FileId, file.MimeType).Context(ctx).Download()
+	default:
+		resp, err = service.Files.Get(file.FileId).SupportsAllDrives(true).Context(ctx).Download()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	content, truncated, err := readDriveContent(resp.Body, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return driveContent{Content: content, Truncated: truncated}, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listSpreadsheetContents returns a row per sheet of the spreadsheet which has values
+func listSpreadsheetContents(ctx context.Context, d *plugin.QueryData, userEmail string, file *drive.File) ([]driveFileContent, error) {
+	// https://developers.google.com/workspace/sheets/api/reference/rest/v4/spreadsheets/get#authorization-scopes
+	service, err := SheetsServiceForUser(ctx, d, userEmail, sheets.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	spreadsheet, err := service.Spreadsheets.Get(file.Id).Fields("sheets(properties(title, sheetType))").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only grid sheets have values, unlike object sheets which hold a chart
+	var contents []driveFileContent
+	for _, sheet := range spreadsheet.Sheets {
+		if sheet.Properties != nil && sheet.Properties.SheetType == "GRID" {
+			contents = append(contents, driveFileContent{
+				FileId:       file.Id,
+				FileMimeType: file.MimeType,
+				Part:         sheet.Properties.Title,
+				MimeType:     "text/csv",
+			})
+		}
+	}
+
+	return contents, nil
+}
+
+// getSheetContent returns the values of the sheet as CSV
+func getSheetContent(ctx context.Context, d *plugin.QueryData, userEmail string, file driveFileContent, maxSize int) (interface{}, error) {
+	// https://developers.google.com/workspace/sheets/api/reference/rest/v4/spreadsheets.values/get#authorization-scopes
+	service, err := SheetsServiceForUser(ctx, d, userEmail, sheets.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetRange := "'" + strings.ReplaceAll(file.Part, "'", "''") + "'"
+	resp, err := service.Spreadsheets.Values.Get(file.FileId, sheetRange).ValueRenderOption("FORMATTED_VALUE").Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	for _, row := range resp.Values {
+		record := make([]string, len(row))
+		for j, value := range row {
+			record[j] = fmt.Sprint(value)
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	content, truncated, err := readDriveContent(&buf, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return driveContent{Content: content, Truncated: truncated}, nil
+}
+
+// readDriveContent reads up to maxSize bytes of the content, and returns whether it has been truncated
+func readDriveContent(r io.Reader, maxSize int) (string, bool, error) {
+	data, err := io.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if err != nil {
+		return "", false, err
+	}
+
+	truncated := len(data) > maxSize
+	if truncated {
+		data = data[:maxSize]
+	}
+
+	// Truncation may split a multi-byte character
+	return strings.ToValidUTF8(string(data), ""), truncated, nil
+}
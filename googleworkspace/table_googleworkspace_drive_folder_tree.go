@@ -0,0 +1,547 @@
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"golang.org/x/sync/singleflight"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	driveFolderMimeType   = "application/vnd.google-apps.folder"
+	driveShortcutMimeType = "application/vnd.google-apps.shortcut"
+
+	// The root of the items whose parent folders are not accessible, such as the files shared with the user
+	driveSharedWithMeRoot = "/Shared with me"
+)
+
+// The fields of the folders required to resolve their paths
+const driveFolderFields = "id, name, mimeType, parents, driveId, shortcutDetails"
+
+type driveFolder = struct {
+	Id               string
+	Name             string
+	MimeType         string
+	DriveId          string
+	ParentId         string
+	Path             string
+	Depth            int
+	ShortcutTargetId string
+	TargetPath       string
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveFolderTree(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_folder_tree",
+		Description: "Folders of the My Drive and the shared drives of the user, with their full path.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveFolderTree,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "path",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "~~"},
+				},
+				{
+					Name:    "user_email",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the folder.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "path",
+				Description: "The full path of the folder, starting with /My Drive, /Shared with me or the name of the shared drive. A folder with multiple parents has a row per path.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_id",
+				Description: "The ID of the parent folder of this path. Empty for the root of the My Drive and of the shared drives.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "depth",
+				Description: "The depth of the folder in the tree, which is 0 for the root of the My Drive and of the shared drives.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Depth"),
+			},
+			{
+				Name:        "mime_type",
+				Description: "The MIME type of the folder. Possible values are: application/vnd.google-apps.folder and application/vnd.google-apps.shortcut.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drive_id",
+				Description: "ID of the shared drive the folder resides in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "shortcut_target_id",
+				Description: "The ID of the folder the shortcut points to. Only populated for shortcuts to folders, whose content is listed at the path of the target.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "target_path",
+				Description: "The path of the folder the shortcut points to, if it is accessible.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "user_email",
+				Description: "The email of the user to impersonate to list the folders. Defaults to the impersonated_user_email of the connection. Requires domain-wide delegation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_email"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveFolderTree(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/list#authorization-scopes
+	service, err := DriveServiceForUser(ctx, d, d.EqualsQualString("user_email"), drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The folders are memoised for this query only, so that renamed folders are picked up by the next one
+	resolver := newDriveFolderResolver(service)
+
+	// Only list the drives which may contain paths starting with the prefix given in the query
+	prefix := driveFolderPathPrefix(d)
+
+	// List the folders of the My Drive, and the ones shared with the user
+	root, err := resolver.root(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if root != nil && (driveRootMatchesPrefix("/"+root.Name, prefix) || driveRootMatchesPrefix(driveSharedWithMeRoot, prefix)) {
+		more, err := streamDriveFolderTree(ctx, d, resolver, service.Files.List().Corpora("user"), root, prefix)
+		if err != nil || !more {
+			return nil, err
+		}
+	}
+
+	// List the shared drives of the user
+	var drives []*drive.Drive
+	if err := service.Drives.List().Fields("nextPageToken, drives(id, name)").PageSize(100).Pages(ctx, func(page *drive.DriveList) error {
+		drives = append(drives, page.Drives...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, sharedDrive := range drives {
+		resolver.addDrive(sharedDrive.Id, sharedDrive.Name)
+		if !driveRootMatchesPrefix("/"+sharedDrive.Name, prefix) {
+			continue
+		}
+
+		call := service.Files.List().Corpora("drive").DriveId(sharedDrive.Id).IncludeItemsFromAllDrives(true).SupportsAllDrives(true)
+		sharedDriveRoot := &drive.File{Id: sharedDrive.Id, Name: sharedDrive.Name, MimeType: driveFolderMimeType, DriveId: sharedDrive.Id}
+		more, err := streamDriveFolderTree(ctx, d, resolver, call, sharedDriveRoot, prefix)
+		if err != nil || !more {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// streamDriveFolderTree streams the root and the folders of a drive whose path starts with the prefix, and returns false once no more rows are needed
+func streamDriveFolderTree(ctx context.Context, d *plugin.QueryData, resolver *driveFolderResolver, call *drive.FilesListCall, root *drive.File, prefix string) (bool, error) {
+	// List all the folders and shortcuts at once, so that their paths are resolved without getting each parent
+	var folders []*drive.File
+	query := "trashed = false and (mimeType = '" + driveFolderMimeType + "' or mimeType = '" + driveShortcutMimeType + "')"
+	if err := call.Q(query).Fields(googleapi.Field("nextPageToken, files("+driveFolderFields+")")).PageSize(1000).Pages(ctx, func(page *drive.FileList) error {
+		for _, file := range page.Files {
+			// Only keep the shortcuts to folders
+			if file.MimeType == driveShortcutMimeType && (file.ShortcutDetails == nil || file.ShortcutDetails.TargetMimeType != driveFolderMimeType) {
+				continue
+			}
+			resolver.addFolder(file)
+			folders = append(folders, file)
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	for _, folder := range append([]*drive.File{root}, folders...) {
+		paths, err := resolver.filePaths(ctx, folder)
+		if err != nil {
+			return false, err
+		}
+
+		for _, path := range paths {
+			if !strings.HasPrefix(path.Path, prefix) {
+				continue
+			}
+
+			row := driveFolder{
+				Id:       folder.Id,
+				Name:     folder.Name,
+				MimeType: folder.MimeType,
+				DriveId:  folder.DriveId,
+				ParentId: path.ParentId,
+				Path:     path.Path,
+				Depth:    path.Depth,
+			}
+
+			// The content of a shortcut is listed at the path of its target, which is not walked again
+			if folder.ShortcutDetails != nil {
+				row.ShortcutTargetId = folder.ShortcutDetails.TargetId
+				targetPaths, err := resolver.folderPaths(ctx, row.ShortcutTargetId)
+				if err != nil {
+					return false, err
+				}
+				if len(targetPaths) > 0 {
+					row.TargetPath = targetPaths[0].Path
+				}
+			}
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveFilePath(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveFilePath")
+	file := h.Item.(*drive.File)
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/get#authorization-scopes
+	userEmail := d.EqualsQualString("user_email")
+	service, err := DriveServiceForUser(ctx, d, userEmail, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The files of a query usually share their parent folders, so memoise them for the duration of the query
+	resolver := getQueryDriveFolderResolver(d, service, userEmail)
+
+	paths, err := resolver.filePaths(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	return paths[0].Path, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The resolvers are kept for a while after their query, since the end of a query is not notified to the plugin
+const driveFolderResolverTTL = 10 * time.Minute
+
+// driveFolderResolversMu ensures that the hydrates of a query share a single resolver
+var driveFolderResolversMu sync.Mutex
+
+// getQueryDriveFolderResolver returns the resolver of the query, creating it on the first call. The resolver is keyed
+// on the context of the query, which is shared by all its hydrates, and is not reused by other queries.
+func getQueryDriveFolderResolver(d *plugin.QueryData, service *drive.Service, userEmail string) *driveFolderResolver {
+	cacheKey := fmt.Sprintf("googleworkspace.drive_folder_resolver - %p - %s", d.QueryContext, userEmail)
+
+	driveFolderResolversMu.Lock()
+	defer driveFolderResolversMu.Unlock()
+
+	// The resolver references the context of its query, so the address of the context cannot be reused by another
+	// query while the resolver is cached
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		if resolver := cachedData.(*driveFolderResolver); resolver.queryContext == d.QueryContext {
+			return resolver
+		}
+	}
+
+	resolver := newDriveFolderResolver(service)
+	resolver.queryContext = d.QueryContext
+	d.ConnectionManager.Cache.SetWithTTL(cacheKey, resolver, driveFolderResolverTTL)
+	return resolver
+}
+
+type driveFilePath struct {
+	ParentId string
+	Path     string
+	Depth    int
+}
+
+// driveFolderResolver resolves the paths of the files, memoising the folders and shared drives it gets.
+// The lock only guards the maps, and concurrent fetches of the same folder or shared drive are deduplicated.
+type driveFolderResolver struct {
+	service      *drive.Service
+	queryContext *plugin.QueryContext
+	fetches      singleflight.Group
+
+	mu         sync.Mutex
+	rootID     string
+	folders    map[string]*drive.File
+	driveNames map[string]string
+	paths      map[string][]driveFilePath
+}
+
+func newDriveFolderResolver(service *drive.Service) *driveFolderResolver {
+	return &driveFolderResolver{
+		service:    service,
+		folders:    map[string]*drive.File{},
+		driveNames: map[string]string{},
+		paths:      map[string][]driveFilePath{},
+	}
+}
+
+// addFolder memoises a folder which has already been listed
+func (r *driveFolderResolver) addFolder(folder *drive.File) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.folders[folder.Id] = folder
+}
+
+// addDrive memoises the name of a shared drive which has already been listed
+func (r *driveFolderResolver) addDrive(id string, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.driveNames[id] = name
+}
+
+// root returns the root folder of the My Drive
+func (r *driveFolderResolver) root(ctx context.Context) (*drive.File, error) {
+	return r.getRoot(ctx)
+}
+
+// filePaths returns a path per parent of the file
+func (r *driveFolderResolver) filePaths(ctx context.Context, file *drive.File) ([]driveFilePath, error) {
+	return r.resolvePaths(ctx, file, map[string]bool{})
+}
+
+// folderPaths returns the paths of the given folder, or nil if it is not accessible
+func (r *driveFolderResolver) folderPaths(ctx context.Context, id string) ([]driveFilePath, error) {
+	return r.resolveFolderPaths(ctx, id, map[string]bool{})
+}
+
+func (r *driveFolderResolver) resolveFolderPaths(ctx context.Context, id string, visited map[string]bool) ([]driveFilePath, error) {
+	r.mu.Lock()
+	paths, ok := r.paths[id]
+	r.mu.Unlock()
+	if ok {
+		return paths, nil
+	}
+
+	folder, err := r.getFolder(ctx, id)
+	if err != nil || folder == nil {
+		return nil, err
+	}
+
+	paths, err = r.resolvePaths(ctx, folder, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.paths[id] = paths
+	r.mu.Unlock()
+	return paths, nil
+}
+
+func (r *driveFolderResolver) resolvePaths(ctx context.Context, file *drive.File, visited map[string]bool) ([]driveFilePath, error) {
+	// Guard against cycles, which Drive does not allow but legacy files with multiple parents may have
+	if visited[file.Id] {
+		return nil, nil
+	}
+	visited[file.Id] = true
+
+	// The root folder of a shared drive is named after the shared drive
+	if file.DriveId != "" && (file.DriveId == file.Id || len(file.Parents) == 0) {
+		name, err := r.getDriveName(ctx, file.DriveId)
+		if err != nil {
+			return nil, err
+		}
+		return []driveFilePath{{Path: "/" + name}}, nil
+	}
+
+	if len(file.Parents) == 0 {
+		root, err := r.getRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if root != nil && root.Id == file.Id {
+			return []driveFilePath{{Path: "/" + root.Name}}, nil
+		}
+
+		// The parents of the items shared with the user are omitted if they are not accessible
+		return []driveFilePath{{Path: driveSharedWithMeRoot + "/" + file.Name, Depth: 1}}, nil
+	}
+
+	var paths []driveFilePath
+	for _, parentID := range file.Parents {
+		parentPaths, err := r.resolveFolderPaths(ctx, parentID, visited)
+		if err != nil {
+			return nil, err
+		}
+		if len(parentPaths) == 0 {
+			parentPaths = []driveFilePath{{Path: driveSharedWithMeRoot}}
+		}
+		for _, parentPath := range parentPaths {
+			paths = append(paths, driveFilePath{ParentId: parentID, Path: parentPath.Path + "/" + file.Name, Depth: parentPath.Depth + 1})
+		}
+	}
+
+	return paths, nil
+}
+
+func (r *driveFolderResolver) getRoot(ctx context.Context) (*drive.File, error) {
+	r.mu.Lock()
+	rootID := r.rootID
+	root := r.folders[rootID]
+	r.mu.Unlock()
+	if rootID != "" {
+		return root, nil
+	}
+
+	root, err := r.getFolder(ctx, "root")
+	if err != nil || root == nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.rootID = root.Id
+	r.mu.Unlock()
+	return root, nil
+}
+
+func (r *driveFolderResolver) getFolder(ctx context.Context, id string) (*drive.File, error) {
+	r.mu.Lock()
+	folder, ok := r.folders[id]
+	r.mu.Unlock()
+	if ok {
+		return folder, nil
+	}
+
+	result, err, _ := r.fetches.Do("folder - "+id, func() (interface{}, error) {
+		folder, err := r.service.Files.Get(id).Fields(googleapi.Field(driveFolderFields)).SupportsAllDrives(true).Context(ctx).Do()
+		if err != nil {
+			// The parent folders of the items shared with the user may not be accessible
+			if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 403 || gerr.Code == 404) {
+				folder = nil
+			} else {
+				return nil, err
+			}
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.folders[id] = folder
+		// The root folder of the My Drive is requested by its alias
+		if folder != nil {
+			r.folders[folder.Id] = folder
+		}
+		return folder, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*drive.File), nil
+}
+
+func (r *driveFolderResolver) getDriveName(ctx context.Context, id string) (string, error) {
+	r.mu.Lock()
+	name, ok := r.driveNames[id]
+	r.mu.Unlock()
+	if ok {
+		return name, nil
+	}
+
+	result, err, _ := r.fetches.Do("drive - "+id, func() (interface{}, error) {
+		sharedDrive, err := r.service.Drives.Get(id).Fields("id, name").Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.driveNames[id] = sharedDrive.Name
+		return sharedDrive.Name, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result.(string), nil
+}
+
+// driveFolderPathPrefix returns the literal prefix of the paths given in the query
+func driveFolderPathPrefix(d *plugin.QueryData) string {
+	var prefix string
+	if d.Quals["path"] == nil {
+		return prefix
+	}
+
+	for _, q := range d.Quals["path"].Quals {
+		value := q.Value.GetStringValue()
+		if q.Operator == "~~" {
+			value = likePatternPrefix(value)
+		}
+
+		// All the quals must match, so use the most selective one
+		if len(value) > len(prefix) {
+			prefix = value
+		}
+	}
+
+	return prefix
+}
+
+// likePatternPrefix returns the literal text of a LIKE pattern before its first wildcard
+func likePatternPrefix(pattern string) string {
+	var prefix strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '%', '_':
+			return prefix.String()
+		case '\\':
+			i++
+			if i < len(pattern) {
+				prefix.WriteByte(pattern[i])
+			}
+		default:
+			prefix.WriteByte(pattern[i])
+		}
+	}
+	return prefix.String()
+}
+
+// driveRootMatchesPrefix returns whether the paths under the root may start with the prefix
+func driveRootMatchesPrefix(root string, prefix string) bool {
+	return strings.HasPrefix(root, prefix) || strings.HasPrefix(prefix, root+"/")
+}
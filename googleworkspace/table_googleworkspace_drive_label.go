@@ -0,0 +1,300 @@
+package googleworkspace
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drivelabels/v2"
+)
+
+type driveLabelChoice struct {
+	FieldId     string `json:"fieldId"`
+	FieldName   string `json:"fieldName"`
+	ChoiceId    string `json:"choiceId"`
+	DisplayName string `json:"displayName"`
+	State       string `json:"state,omitempty"`
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveLabel(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_label",
+		Description: "Drive labels which can be applied to classify the files in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveLabels,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "use_admin_access",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "published_only",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "minimum_role",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "id",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "use_admin_access",
+					Require: plugin.Optional,
+				},
+			},
+			Hydrate: getDriveLabel,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The ID of the label, which is used in the labels of the files.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The resource name of the label, in the form labels/{id} or labels/{id}@{revision_id}.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "title",
+				Description: "The title of the label.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Title"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the label.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "label_type",
+				Description: "The type of the label. Possible values are: SHARED, ADMIN and GOOGLE_APP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The lifecycle state of the label. Possible values are: UNPUBLISHED_DRAFT, PUBLISHED, DISABLED and DELETED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Lifecycle.State"),
+			},
+			{
+				Name:        "has_unpublished_changes",
+				Description: "Indicates whether the label has draft changes which have not been published yet, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Lifecycle.HasUnpublishedChanges"),
+			},
+			{
+				Name:        "revision_id",
+				Description: "The revision ID of the label.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "customer",
+				Description: "The customer the label belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The time at which the label was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "publish_time",
+				Description: "The time at which the label was published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "disable_time",
+				Description: "The time at which the label was disabled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "learn_more_uri",
+				Description: "A link to learn more about the label and how it should be used.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "use_admin_access",
+				Description: "Whether to use the admin credentials of the user to list all the labels of the customer. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("use_admin_access"),
+			},
+			{
+				Name:        "published_only",
+				Description: "Whether to only list the published revision of the labels. Defaults to true.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("published_only"),
+			},
+			{
+				Name:        "minimum_role",
+				Description: "The minimum role of the user on the labels to list. Possible values are: READER, APPLIER, ORGANIZER and EDITOR. Defaults to READER.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("minimum_role"),
+			},
+			{
+				Name:        "creator",
+				Description: "The user who created the label.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "lifecycle",
+				Description: "The lifecycle of the label, including its state and its disabled policy.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "fields",
+				Description: "The fields of the label, in descending priority order.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "choices",
+				Description: "The choices of the selection fields of the label, with the ID and the name of their field.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Fields").Transform(driveLabelChoices),
+			},
+			{
+				Name:        "applied_label_policy",
+				Description: "The behavior of the label when it is applied to Drive items.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveLabels(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/labels/reference/rest/v2/labels/list#authorization-scopes
+	useAdminAccess := d.EqualsQuals["use_admin_access"].GetBoolValue()
+	service, err := driveLabelsService(ctx, d, useAdminAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedOnly := true
+	if d.EqualsQuals["published_only"] != nil {
+		publishedOnly = d.EqualsQuals["published_only"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 200 records in a single page
+	pageSize := int64(200)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	resp := service.Labels.List().View("LABEL_VIEW_FULL").UseAdminAccess(useAdminAccess).PublishedOnly(publishedOnly).PageSize(pageSize)
+
+	// The minimum role is ignored with the admin access, which returns all the labels of the customer
+	if minimumRole := d.EqualsQualString("minimum_role"); minimumRole != "" && !useAdminAccess {
+		resp = resp.MinimumRole(minimumRole)
+	}
+
+	if err := resp.Pages(ctx, func(page *drivelabels.GoogleAppsDriveLabelsV2ListLabelsResponse) error {
+		for _, label := range page.Labels {
+			d.StreamListItem(ctx, label)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveLabel(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveLabel")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/labels/reference/rest/v2/labels/get#authorization-scopes
+	useAdminAccess := d.EqualsQuals["use_admin_access"].GetBoolValue()
+	service, err := driveLabelsService(ctx, d, useAdminAccess)
+	if err != nil {
+		return nil, err
+	}
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if id == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Labels.Get("labels/" + strings.TrimPrefix(id, "labels/")).View("LABEL_VIEW_FULL").UseAdminAccess(useAdminAccess).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// driveLabelsService returns a Drive Labels service with the admin scope if the admin access is used
+func driveLabelsService(ctx context.Context, d *plugin.QueryData, useAdminAccess bool) (*drivelabels.Service, error) {
+	if useAdminAccess {
+		return DriveLabelsServiceWithScope(ctx, d, drivelabels.DriveAdminLabelsReadonlyScope)
+	}
+	return DriveLabelsServiceWithScope(ctx, d, drivelabels.DriveLabelsReadonlyScope)
+}
+
+//// TRANSFORM FUNCTIONS
+
+// driveLabelChoices flattens the choices of the selection fields of the label
+func driveLabelChoices(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	fields, ok := d.Value.([]*drivelabels.GoogleAppsDriveLabelsV2Field)
+	if !ok {
+		return nil, nil
+	}
+
+	var choices []driveLabelChoice
+	for _, field := range fields {
+		if field.SelectionOptions == nil {
+			continue
+		}
+
+		var fieldName string
+		if field.Properties != nil {
+			fieldName = field.Properties.DisplayName
+		}
+		for _, choice := range field.SelectionOptions.Choices {
+			labelChoice := driveLabelChoice{
+				FieldId:   field.Id,
+				FieldName: fieldName,
+				ChoiceId:  choice.Id,
+			}
+			if choice.Properties != nil {
+				labelChoice.DisplayName = choice.Properties.DisplayName
+			}
+			if choice.Lifecycle != nil {
+				labelChoice.State = choice.Lifecycle.State
+			}
+			choices = append(choices, labelChoice)
+		}
+	}
+
+	return choices, nil
+}
@@ -0,0 +1,208 @@
+package googleworkspace
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+)
+
+type driveMember = struct {
+	DriveId        string
+	DriveName      string
+	MemberDomain   string
+	MemberCount    int
+	OrganizerCount int
+	Permission     *drive.Permission
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_member",
+		Description: "Members of the shared drives in the Google Drive, with their role.",
+		List: &plugin.ListConfig{
+			Hydrate: listDriveMembers,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "drive_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "use_domain_admin_access",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "drive_id",
+				Description: "The ID of the shared drive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drive_name",
+				Description: "The name of the shared drive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the permission of the member. Null if the shared drive has no member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Id"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the member. Possible values are: user, group, domain and anyone.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Type"),
+			},
+			{
+				Name:        "role",
+				Description: "The role of the member on the shared drive. Possible values are: organizer, fileOrganizer, writer, commenter and reader.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.Role"),
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the user or group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.EmailAddress"),
+			},
+			{
+				Name:        "domain",
+				Description: "The domain of the member, which is the domain of the email address for users and groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MemberDomain").NullIfZero(),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Permission.DisplayName"),
+			},
+			{
+				Name:        "deleted",
+				Description: "Indicates whether the account of the member has been deleted, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Permission.Deleted"),
+			},
+			{
+				Name:        "member_count",
+				Description: "The number of members of the shared drive.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MemberCount"),
+			},
+			{
+				Name:        "organizer_count",
+				Description: "The number of members of the shared drive with the organizer role.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("OrganizerCount"),
+			},
+			{
+				Name:        "use_domain_admin_access",
+				Description: "Whether to list the shared drives and their members as a domain administrator, including the shared drives the user is not a member of. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("use_domain_admin_access"),
+			},
+			{
+				Name:        "permission_details",
+				Description: "Details of whether the permission of the member is inherited, and where it is inherited from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Permission.PermissionDetails"),
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/permissions/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	driveID := d.EqualsQualString("drive_id")
+
+	var useDomainAdminAccess bool
+	if d.EqualsQuals["use_domain_admin_access"] != nil {
+		useDomainAdminAccess = d.EqualsQuals["use_domain_admin_access"].GetBoolValue()
+	}
+
+	// List the members of the given shared drive, or of all the shared drives
+	var drives []*drive.Drive
+	if driveID != "" {
+		resp, err := service.Drives.Get(driveID).Fields("id, name").UseDomainAdminAccess(useDomainAdminAccess).Do()
+		if err != nil {
+			return nil, err
+		}
+		drives = append(drives, resp)
+	} else {
+		if err := service.Drives.List().Fields("nextPageToken, drives(id, name)").UseDomainAdminAccess(useDomainAdminAccess).PageSize(100).Pages(ctx, func(page *drive.DriveList) error {
+			drives = append(drives, page.Drives...)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, sharedDrive := range drives {
+		// Get all the members first, so that the organizers can be counted
+		var permissions []*drive.Permission
+		resp := service.Permissions.List(sharedDrive.Id).Fields("nextPageToken, permissions(*)").
+			UseDomainAdminAccess(useDomainAdminAccess).SupportsAllDrives(true).PageSize(100)
+		if err := resp.Pages(ctx, func(page *drive.PermissionList) error {
+			permissions = append(permissions, page.Permissions...)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		var organizerCount int
+		for _, permission := range permissions {
+			if permission.Role == "organizer" {
+				organizerCount++
+			}
+		}
+
+		// Return a row with no member for the shared drives without any member, so that they can be found
+		if len(permissions) == 0 {
+			d.StreamListItem(ctx, driveMember{DriveId: sharedDrive.Id, DriveName: sharedDrive.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+			continue
+		}
+
+		for _, permission := range permissions {
+			member := driveMember{
+				DriveId:        sharedDrive.Id,
+				DriveName:      sharedDrive.Name,
+				MemberDomain:   strings.ToLower(permission.Domain),
+				MemberCount:    len(permissions),
+				OrganizerCount: organizerCount,
+				Permission:     permission,
+			}
+			if permission.Type == "user" || permission.Type == "group" {
+				member.MemberDomain = emailDomain(permission.EmailAddress)
+			}
+			d.StreamListItem(ctx, member)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
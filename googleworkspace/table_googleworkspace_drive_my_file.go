@@ -2,7 +2,9 @@ package googleworkspace
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"time"
@@ -115,6 +117,18 @@ func driveFileColumns() []*plugin.Column {
 			Description: "The MD5 checksum for the content of the file.",
 			Type:        proto.ColumnType_STRING,
 		},
+		{
+			Name:        "full_text",
+			Description: "Search the name, description, indexable text and content of the files. Only used as a search term of the query.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("full_text"),
+		},
+		{
+			Name:        "label_id",
+			Description: "The ID of a Drive label applied to the files. Only used as a search term of the query, use the labels column to get the labels of the files.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("label_id"),
+		},
 		{
 			Name:        "modified_by_me",
 			Description: "Indicates whether the file has been modified by this user, or not.",
@@ -136,9 +150,16 @@ func driveFileColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Transform:   transform.FromField("OriginalFilename").NullIfZero(),
 		},
+		{
+			Name:        "path",
+			Description: "The full path of the file, starting with /My Drive, /Shared with me or the name of the shared drive. Only the path of the first parent is returned for files with multiple parents.",
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getDriveFilePath,
+			Transform:   transform.FromValue(),
+		},
 		{
 			Name:        "query",
-			Description: "A search query combining one or more search terms to [filter](https://developers.google.com/drive/api/v3/search-files) the file results.",
+			Description: "A search query combining one or more search terms to [filter](https://developers.google.com/drive/api/v3/search-files) the file results. It is combined with the search terms of the other quals using and.",
 			Type:        proto.ColumnType_STRING,
 			Transform:   transform.FromQual("query"),
 		},
@@ -252,6 +273,13 @@ func driveFileColumns() []*plugin.Column {
 			Description: "The last user to modify the file.",
 			Type:        proto.ColumnType_JSON,
 		},
+		{
+			Name:        "labels",
+			Description: "The Drive labels applied to the file, with the values of their fields.",
+			Type:        proto.ColumnType_JSON,
+			Hydrate:     listDriveFileLabels,
+			Transform:   transform.FromValue(),
+		},
 		{
 			Name:        "link_share_metadata",
 			Description: "Contains details about the link URLs that clients are using to refer to this item.",
@@ -310,32 +338,85 @@ func driveFileColumns() []*plugin.Column {
 	}
 }
 
+// driveFileQueryKeyColumns returns the key columns which are pushed down into the search query of the files
+func driveFileQueryKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:    "name",
+			Require: plugin.Optional,
+		},
+		{
+			Name:      "created_time",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+		{
+			Name:      "modified_time",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+		{
+			Name:      "viewed_by_me_time",
+			Require:   plugin.Optional,
+			Operators: []string{">", ">=", "=", "<", "<="},
+		},
+		{
+			Name:      "mime_type",
+			Require:   plugin.Optional,
+			Operators: []string{"=", "<>", "!="},
+		},
+		{
+			Name:      "trashed",
+			Require:   plugin.Optional,
+			Operators: []string{"=", "<>"},
+		},
+		{
+			Name:      "starred",
+			Require:   plugin.Optional,
+			Operators: []string{"=", "<>"},
+		},
+		{
+			Name:      "owned_by_me",
+			Require:   plugin.Optional,
+			Operators: []string{"=", "<>"},
+		},
+		{
+			Name:      "parents",
+			Require:   plugin.Optional,
+			Operators: []string{"?", "@>"},
+		},
+		{
+			Name:      "properties",
+			Require:   plugin.Optional,
+			Operators: []string{"@>"},
+		},
+		{
+			Name:      "app_properties",
+			Require:   plugin.Optional,
+			Operators: []string{"@>"},
+		},
+		{
+			Name:    "full_text",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "label_id",
+			Require: plugin.Optional,
+		},
+		{
+			Name:    "query",
+			Require: plugin.Optional,
+		},
+	}
+}
+
 func tableGoogleWorkspaceDriveMyFile(_ context.Context) *plugin.Table {
 	return &plugin.Table{
 		Name:        "googleworkspace_drive_my_file",
 		Description: "Retrieves file's metadata or content owned by an user.",
 		List: &plugin.ListConfig{
-			Hydrate: listDriveMyFiles,
-			KeyColumns: []*plugin.KeyColumn{
-				{
-					Name:    "name",
-					Require: plugin.Optional,
-				},
-				{
-					Name:      "created_time",
-					Require:   plugin.Optional,
-					Operators: []string{">", ">=", "=", "<", "<="},
-				},
-				{
-					Name:      "mime_type",
-					Require:   plugin.Optional,
-					Operators: []string{"=", "<>", "!="},
-				},
-				{
-					Name:    "query",
-					Require: plugin.Optional,
-				},
-			},
+			Hydrate:    listDriveMyFiles,
+			KeyColumns: driveFileQueryKeyColumns(),
 		},
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
@@ -355,60 +436,7 @@ func listDriveMyFiles(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 		return nil, err
 	}
 
-	equalQuals := d.EqualsQuals
-	quals := d.Quals
-
-	var queryFilter, query string
-	var filter []string
-
-	if equalQuals["name"] != nil {
-		filter = append(filter, fmt.Sprintf("%s = \"%s\"", "name", equalQuals["name"].GetStringValue()))
-	}
-
-	if quals["created_time"] != nil {
-		for _, q := range quals["created_time"].Quals {
-			givenTime := q.Value.GetTimestampValue().AsTime()
-			beforeTime := givenTime.Add(time.Duration(-1) * time.Second).Format("2006-01-02T15:04:05.000Z")
-			afterTime := givenTime.Add(time.Second * 1).Format("2006-01-02T15:04:05.000Z")
-
-			// Since, the query filter matches the actual time
-			switch q.Operator {
-			case ">", "<":
-				filter = append(filter, fmt.Sprintf("%s %s \"%s\"", "createdTime", q.Operator, givenTime.Format("2006-01-02T15:04:05.000Z")))
-			case "=":
-				filter = append(filter, fmt.Sprintf("createdTime > \"%s\" and createdTime < \"%s\"", beforeTime, afterTime))
-			case ">=":
-				filter = append(filter, fmt.Sprintf("%s > \"%s\"", "createdTime", beforeTime))
-			case "<=":
-				filter = append(filter, fmt.Sprintf("%s < \"%s\"", "createdTime", afterTime))
-			}
-		}
-	}
-
-	if quals["mime_type"] != nil {
-		for _, q := range quals["mime_type"].Quals {
-			mimeType := q.Value.GetStringValue()
-
-			switch q.Operator {
-			case "=":
-				filter = append(filter, fmt.Sprintf("%s = \"%s\"", "mimeType", mimeType))
-			case "!=", "<>":
-				filter = append(filter, fmt.Sprintf("%s != \"%s\"", "mimeType", mimeType))
-			}
-		}
-	}
-
-	// Query string for searching files. Refer https://developers.google.com/drive/api/v3/search-files
-	// For example, "name contains 'steampipe'", returns all the files containing the word 'steampipe'
-	if equalQuals["query"] != nil {
-		queryFilter = equalQuals["query"].GetStringValue()
-	}
-
-	if queryFilter != "" {
-		query = queryFilter
-	} else if len(filter) > 0 {
-		query = strings.Join(filter, " and ")
-	}
+	query := buildDriveFileQuery(d)
 
 	// Check for query context and requests only for queried columns
 	givenColumns := d.QueryContext.Columns
@@ -477,6 +505,181 @@ func getDriveMyFile(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return resp, nil
 }
 
+// listDriveFileLabels :: Return the labels applied to the file
+func listDriveFileLabels(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listDriveFileLabels")
+	file := h.Item.(*drive.File)
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/files/listLabels#authorization-scopes
+	service, err := DriveServiceForUser(ctx, d, d.EqualsQualString("user_email"), drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []*drive.Label
+	if err := service.Files.ListLabels(file.Id).MaxResults(100).Pages(ctx, func(page *drive.LabelList) error {
+		labels = append(labels, page.Labels...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// buildDriveFileQuery :: Return the search query for the given quals, combined with the query qual if any
+func buildDriveFileQuery(d *plugin.QueryData) string {
+	equalQuals := d.EqualsQuals
+	quals := d.Quals
+
+	var filter []string
+
+	if quals["name"] != nil {
+		for _, q := range quals["name"].Quals {
+			name := q.Value.GetStringValue()
+
+			// The contains operator matches whole words of the name, rather than any prefix, so LIKE patterns are not
+			// pushed down, and are only filtered by Steampipe
+			if q.Operator == "=" {
+				filter = append(filter, fmt.Sprintf("name = '%s'", escapeDriveQueryValue(name)))
+			}
+		}
+	}
+
+	filter = append(filter, driveTimeQueryFilters(quals, "created_time", "createdTime")...)
+	filter = append(filter, driveTimeQueryFilters(quals, "modified_time", "modifiedTime")...)
+	filter = append(filter, driveTimeQueryFilters(quals, "viewed_by_me_time", "viewedByMeTime")...)
+
+	if quals["mime_type"] != nil {
+		for _, q := range quals["mime_type"].Quals {
+			mimeType := escapeDriveQueryValue(q.Value.GetStringValue())
+
+			switch q.Operator {
+			case "=":
+				filter = append(filter, fmt.Sprintf("mimeType = '%s'", mimeType))
+			case "!=", "<>":
+				filter = append(filter, fmt.Sprintf("mimeType != '%s'", mimeType))
+			}
+		}
+	}
+
+	for _, field := range []string{"trashed", "starred"} {
+		if quals[field] == nil {
+			continue
+		}
+		for _, q := range quals[field].Quals {
+			value := q.Value.GetBoolValue()
+			if q.Operator == "!=" || q.Operator == "<>" {
+				value = !value
+			}
+			filter = append(filter, fmt.Sprintf("%s = %t", field, value))
+		}
+	}
+
+	if quals["owned_by_me"] != nil {
+		for _, q := range quals["owned_by_me"].Quals {
+			value := q.Value.GetBoolValue()
+			if q.Operator == "!=" || q.Operator == "<>" {
+				value = !value
+			}
+			if value {
+				filter = append(filter, "'me' in owners")
+			} else {
+				filter = append(filter, "not 'me' in owners")
+			}
+		}
+	}
+
+	if quals["parents"] != nil {
+		for _, q := range quals["parents"].Quals {
+			var parents []string
+			switch q.Operator {
+			case "?":
+				parents = []string{q.Value.GetStringValue()}
+			case "@>":
+				// Values which are not an array of IDs are left to Postgres
+				_ = json.Unmarshal([]byte(q.Value.GetJsonbValue()), &parents)
+			}
+			for _, parent := range parents {
+				filter = append(filter, fmt.Sprintf("'%s' in parents", escapeDriveQueryValue(parent)))
+			}
+		}
+	}
+
+	for _, column := range []string{"properties", "app_properties"} {
+		field := strcase.ToLowerCamel(column)
+		if quals[column] == nil {
+			continue
+		}
+		for _, q := range quals[column].Quals {
+			if q.Operator != "@>" {
+				continue
+			}
+			// Values which are not an object of strings are left to Postgres
+			var properties map[string]string
+			if err := json.Unmarshal([]byte(q.Value.GetJsonbValue()), &properties); err != nil {
+				continue
+			}
+			for _, key := range slices.Sorted(maps.Keys(properties)) {
+				filter = append(filter, fmt.Sprintf("%s has { key='%s' and value='%s' }", field, escapeDriveQueryValue(key), escapeDriveQueryValue(properties[key])))
+			}
+		}
+	}
+
+	if equalQuals["full_text"] != nil {
+		filter = append(filter, fmt.Sprintf("fullText contains '%s'", escapeDriveQueryValue(equalQuals["full_text"].GetStringValue())))
+	}
+
+	if equalQuals["label_id"] != nil {
+		filter = append(filter, fmt.Sprintf("'labels/%s' in labels", escapeDriveQueryValue(strings.TrimPrefix(equalQuals["label_id"].GetStringValue(), "labels/"))))
+	}
+
+	// Query string for searching files. Refer https://developers.google.com/drive/api/v3/search-files
+	// For example, "name contains 'steampipe'", returns all the files containing the word 'steampipe'
+	if queryFilter := equalQuals["query"].GetStringValue(); queryFilter != "" {
+		filter = append([]string{"(" + queryFilter + ")"}, filter...)
+	}
+
+	return strings.Join(filter, " and ")
+}
+
+// driveTimeQueryFilters :: Return the search terms for the time quals of the column
+func driveTimeQueryFilters(quals plugin.KeyColumnQualMap, column string, field string) []string {
+	var filter []string
+	if quals[column] == nil {
+		return filter
+	}
+
+	for _, q := range quals[column].Quals {
+		givenTime := q.Value.GetTimestampValue().AsTime()
+		beforeTime := givenTime.Add(time.Duration(-1) * time.Second).Format("2006-01-02T15:04:05.000Z")
+		afterTime := givenTime.Add(time.Second * 1).Format("2006-01-02T15:04:05.000Z")
+
+		// Since, the query filter matches the actual time
+		switch q.Operator {
+		case ">", "<":
+			filter = append(filter, fmt.Sprintf("%s %s '%s'", field, q.Operator, givenTime.Format("2006-01-02T15:04:05.000Z")))
+		case "=":
+			filter = append(filter, fmt.Sprintf("%s > '%s' and %s < '%s'", field, beforeTime, field, afterTime))
+		case ">=":
+			filter = append(filter, fmt.Sprintf("%s > '%s'", field, beforeTime))
+		case "<=":
+			filter = append(filter, fmt.Sprintf("%s < '%s'", field, afterTime))
+		}
+	}
+
+	return filter
+}
+
+// escapeDriveQueryValue :: Escape the quotes and backslashes of a string value of the search query
+func escapeDriveQueryValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
+
+// Columns which are only used as quals, or are hydrated separately, and are not fields of the file
+var driveFileQualColumns = []string{"query", "full_text", "label_id", "labels", "corpora", "include_items_from_all_drives", "supports_all_drives", "user_email"}
+
 // buildDriveFileRequestFields :: Return columns passed in query context
 func buildDriveFileRequestFields(ctx context.Context, queryColumns []string) []googleapi.Field {
 	var fields []string
@@ -489,17 +692,22 @@ func buildDriveFileRequestFields(ctx context.Context, queryColumns []string) []g
 
 	for _, columnName := range queryColumns {
 		// Optional columns
-		if columnName == "query" || columnName == "_ctx" {
+		if slices.Contains(driveFileQualColumns, columnName) || columnName == "_ctx" {
 			continue
 		}
 
 		switch columnName {
 		case "original_file_name":
 			fields = append(fields, "originalFilename")
+		case "path":
+			// The path is resolved from the name and the parents of the file
+			fields = append(fields, "name", "parents", "driveId")
 		default:
 			fields = append(fields, strcase.ToLowerCamel(columnName))
 		}
 	}
+	slices.Sort(fields)
+	fields = slices.Compact(fields)
 
 	givenFields := strings.Join(fields, ", ")
 	requestedFields = append(requestedFields, googleapi.Field(fmt.Sprintf("nextPageToken, files(%s)", givenFields)))
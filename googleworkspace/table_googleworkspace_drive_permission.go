@@ -0,0 +1,263 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type drivePermission = struct {
+	FileId  string
+	DriveId string
+	drive.Permission
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDrivePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_permission",
+		Description: "Permissions of a file or a shared drive in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate: listDrivePermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "file_id",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "drive_id",
+					Require: plugin.AnyOf,
+				},
+				{
+					Name:    "use_domain_admin_access",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"file_id", "id"}),
+			Hydrate:    getDrivePermission,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file or shared drive the permission applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of this permission.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drive_id",
+				Description: "The ID of the shared drive, if the permissions of a shared drive are listed.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the grantee. Possible values are: user, group, domain and anyone.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The role granted by this permission. Possible values are: owner, organizer, fileOrganizer, writer, commenter and reader.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "email_address",
+				Description: "The email address of the user or group to which this permission refers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain",
+				Description: "The domain to which this permission refers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The displayable name of users, groups or domains.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "allow_file_discovery",
+				Description: "Indicates whether the permission allows the file to be discovered through search, or not. This is only applicable for permissions of type domain or anyone.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AllowFileDiscovery"),
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time at which this permission will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "deleted",
+				Description: "Indicates whether the account associated with this permission has been deleted, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Deleted"),
+			},
+			{
+				Name:        "pending_owner",
+				Description: "Indicates whether the account associated with this permission is a pending owner, or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PendingOwner"),
+			},
+			{
+				Name:        "inherited",
+				Description: "Indicates whether this permission is only inherited from a parent folder, or not. Only populated for items in shared drives.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PermissionDetails").Transform(drivePermissionInherited),
+			},
+			{
+				Name:        "inherited_from",
+				Description: "The ID of the item from which this permission is inherited, if any. Only populated for items in shared drives.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PermissionDetails").Transform(drivePermissionInheritedFrom),
+			},
+			{
+				Name:        "photo_link",
+				Description: "A link to the user's profile photo, if available.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "view",
+				Description: "Indicates the view for this permission. Only populated for permissions that belong to a view.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "use_domain_admin_access",
+				Description: "Issue the request as a domain administrator; if set to true, then the requester will be granted access if the file ID parameter refers to a shared drive and the requester is an administrator of the domain to which the shared drive belongs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromQual("use_domain_admin_access"),
+			},
+			{
+				Name:        "permission_details",
+				Description: "Details of whether the permissions on this shared drive item are inherited or directly on this item.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDrivePermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/permissions/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The permissions of a shared drive are the permissions of its top level folder, which has the same ID
+	driveID := d.EqualsQualString("drive_id")
+	fileID := d.EqualsQualString("file_id")
+	if fileID == "" {
+		fileID = driveID
+	}
+
+	// Return nil, if no input provided
+	if fileID == "" {
+		return nil, nil
+	}
+
+	// Set default as false
+	// Need to set true to list the permissions of shared drives the requester is not a member of
+	var useDomainAdminAccess bool
+	if d.EqualsQuals["use_domain_admin_access"] != nil {
+		useDomainAdminAccess = d.EqualsQuals["use_domain_admin_access"].GetBoolValue()
+	}
+
+	// By default, API can return maximum 100 records in a single page
+	pageSize := int64(100)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	resp := service.Permissions.List(fileID).Fields("nextPageToken, permissions(*)").SupportsAllDrives(true).UseDomainAdminAccess(useDomainAdminAccess).PageSize(pageSize)
+	if err := resp.Pages(ctx, func(page *drive.PermissionList) error {
+		for _, permission := range page.Permissions {
+			d.StreamListItem(ctx, drivePermission{FileId: fileID, DriveId: driveID, Permission: *permission})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDrivePermission(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDrivePermission")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/permissions/get#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if fileID == "" || id == "" {
+		return nil, nil
+	}
+
+	resp, err := service.Permissions.Get(fileID, id).Fields(googleapi.Field("*")).SupportsAllDrives(true).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return drivePermission{FileId: fileID, Permission: *resp}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// drivePermissionInherited returns true if all the details of the permission are inherited from a parent folder
+func drivePermissionInherited(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details, ok := d.Value.([]*drive.PermissionPermissionDetails)
+	if !ok || len(details) == 0 {
+		return nil, nil
+	}
+
+	for _, detail := range details {
+		if detail != nil && !detail.Inherited {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// drivePermissionInheritedFrom returns the ID of the item from which the permission is inherited, if any
+func drivePermissionInheritedFrom(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	details, ok := d.Value.([]*drive.PermissionPermissionDetails)
+	if !ok {
+		return nil, nil
+	}
+
+	for _, detail := range details {
+		if detail != nil && detail.Inherited && detail.InheritedFrom != "" {
+			return detail.InheritedFrom, nil
+		}
+	}
+	return nil, nil
+}
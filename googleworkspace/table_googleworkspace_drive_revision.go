@@ -0,0 +1,221 @@
+package googleworkspace
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+type driveRevision = struct {
+	FileId string
+	drive.Revision
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveRevision(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_revision",
+		Description: "Revisions of a file in the Google Drive.",
+		List: &plugin.ListConfig{
+			Hydrate:    listDriveRevisions,
+			KeyColumns: plugin.SingleColumn("file_id"),
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"file_id", "id"}),
+			Hydrate:    getDriveRevision,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "file_id",
+				Description: "The ID of the file.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "modified_time",
+				Description: "The last time the revision was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "mime_type",
+				Description: "The MIME type of the revision.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "keep_forever",
+				Description: "Indicates whether to keep this revision forever, even if it is no longer the head revision, or not. Only applicable to files with binary content in Google Drive.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("KeepForever"),
+			},
+			{
+				Name:        "published",
+				Description: "Indicates whether this revision is published, or not. Only applicable to Docs Editors files.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Published"),
+			},
+			{
+				Name:        "publish_auto",
+				Description: "Indicates whether subsequent revisions will be automatically republished, or not. Only applicable to Docs Editors files.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublishAuto"),
+			},
+			{
+				Name:        "published_outside_domain",
+				Description: "Indicates whether this revision is published outside the domain, or not. Only applicable to Docs Editors files.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("PublishedOutsideDomain"),
+			},
+			{
+				Name:        "published_link",
+				Description: "A link to the published revision. Only populated for Google Sites files.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "size",
+				Description: "The size of the revision's content in bytes. Only applicable to files with binary content in Google Drive.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "md5_checksum",
+				Description: "The MD5 checksum of the revision's content. Only applicable to files with binary content in Google Drive.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "original_file_name",
+				Description: "The original filename used to create this revision. Only applicable to files with binary content in Google Drive.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginalFilename").NullIfZero(),
+			},
+			{
+				Name:        "last_modifying_user",
+				Description: "The last user to modify this revision.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "export_links",
+				Description: "Links for exporting Docs Editors files to specific formats.",
+				Type:        proto.ColumnType_JSON,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveRevisions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/revisions/list#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+
+	// Return nil, if no input provided
+	if fileID == "" {
+		return nil, nil
+	}
+
+	// Check for query context and requests only for queried columns
+	givenColumns := d.QueryContext.Columns
+	requiredFields := buildDriveRevisionRequestFields(ctx, givenColumns)
+
+	// By default, API can return maximum 1000 records in a single page
+	pageSize := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < pageSize {
+			pageSize = *limit
+		}
+	}
+
+	resp := service.Revisions.List(fileID).Fields(googleapi.Field(fmt.Sprintf("nextPageToken, revisions(%s)", requiredFields))).PageSize(pageSize)
+	if err := resp.Pages(ctx, func(page *drive.RevisionList) error {
+		for _, revision := range page.Revisions {
+			d.StreamListItem(ctx, driveRevision{FileId: fileID, Revision: *revision})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				page.NextPageToken = ""
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getDriveRevision(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDriveRevision")
+
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/revisions/get#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	fileID := d.EqualsQualString("file_id")
+	id := d.EqualsQualString("id")
+
+	// Return nil, if no input provided
+	if fileID == "" || id == "" {
+		return nil, nil
+	}
+
+	// Check for query context and requests only for queried columns
+	givenColumns := d.QueryContext.Columns
+	requiredFields := buildDriveRevisionRequestFields(ctx, givenColumns)
+
+	resp, err := service.Revisions.Get(fileID, id).Fields(googleapi.Field(requiredFields)).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return driveRevision{FileId: fileID, Revision: *resp}, nil
+}
+
+// buildDriveRevisionRequestFields :: Return the revision fields for the columns passed in query context
+func buildDriveRevisionRequestFields(ctx context.Context, queryColumns []string) string {
+	var fields []string
+
+	// Since ID is unique, always add in the requested field
+	if !slices.Contains(queryColumns, "id") {
+		queryColumns = append(queryColumns, "id")
+	}
+
+	for _, columnName := range queryColumns {
+		// Optional columns
+		if columnName == "file_id" || columnName == "_ctx" {
+			continue
+		}
+
+		switch columnName {
+		case "original_file_name":
+			fields = append(fields, "originalFilename")
+		default:
+			fields = append(fields, strcase.ToLowerCamel(columnName))
+		}
+	}
+
+	return strings.Join(fields, ", ")
+}
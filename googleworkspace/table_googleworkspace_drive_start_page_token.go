@@ -0,0 +1,73 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+
+	"google.golang.org/api/drive/v3"
+)
+
+type driveStartPageToken = struct {
+	DriveId        string
+	StartPageToken string
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleWorkspaceDriveStartPageToken(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_drive_start_page_token",
+		Description: "The current start page token of the Drive change log, from which the future changes can be listed.",
+		// The start page token moves forward as changes are made
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listDriveStartPageToken,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "drive_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "start_page_token",
+				Description: "The starting page token for listing the future changes.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "drive_id",
+				Description: "The ID of the shared drive for which the start page token is returned. Null for the changes of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listDriveStartPageToken(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create service
+	// https://developers.google.com/workspace/drive/api/reference/rest/v3/changes/getStartPageToken#authorization-scopes
+	service, err := DriveServiceWithScope(ctx, d, drive.DriveReadonlyScope)
+	if err != nil {
+		return nil, err
+	}
+	driveID := d.EqualsQualString("drive_id")
+
+	call := service.Changes.GetStartPageToken().SupportsAllDrives(true)
+	if driveID != "" {
+		call = call.DriveId(driveID)
+	}
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	d.StreamListItem(ctx, driveStartPageToken{DriveId: driveID, StartPageToken: resp.StartPageToken})
+
+	return nil, nil
+}
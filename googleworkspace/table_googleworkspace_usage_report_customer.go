@@ -0,0 +1,69 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	admin "google.golang.org/api/admin/reports/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceUsageReportCustomer(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_usage_report_customer",
+		Description: "Google Workspace customer usage report parameters.",
+		List: &plugin.ListConfig{
+			Hydrate: listGoogleworkspaceUsageReportCustomers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "date", Require: plugin.Required},
+				{Name: "parameters", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "reports", "action": "customerUsageReports.get"},
+		},
+		Columns: usageReportParameterColumns(),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceUsageReportCustomers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// https://developers.google.com/workspace/admin/reports/reference/rest/v1/customerUsageReports/get#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsUsageReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_usage_report_customer.listGoogleworkspaceUsageReportCustomers", "service_error", err)
+		return nil, err
+	}
+
+	date := d.EqualsQualString("date")
+
+	resp := service.CustomerUsageReports.Get(date)
+
+	if parameters := d.EqualsQualString("parameters"); parameters != "" {
+		resp = resp.Parameters(parameters)
+	}
+
+	err = resp.Pages(ctx, func(page *admin.UsageReports) error {
+		// rate limit
+		d.WaitForListRateLimit(ctx)
+
+		for _, report := range page.UsageReports {
+			for _, row := range flattenUsageReport(report) {
+				d.StreamListItem(ctx, row)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_usage_report_customer.listGoogleworkspaceUsageReportCustomers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
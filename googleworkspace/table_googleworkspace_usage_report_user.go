@@ -0,0 +1,238 @@
+package googleworkspace
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	admin "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/googleapi"
+)
+
+// usageReportParameter flattens a single parameter of a usage report,
+// along with the date and entity the report belongs to
+type usageReportParameter struct {
+	Date          string
+	Entity        *admin.UsageReportEntity
+	Name          string
+	IntValue      *int64
+	BoolValue     *bool
+	StringValue   string
+	DatetimeValue string
+	MsgValue      []googleapi.RawMessage
+}
+
+// usageReportParameterColumns returns the columns common to the usage report tables
+func usageReportParameterColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "date",
+			Description: "The date of the report request, in the format yyyy-mm-dd.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "entity_type",
+			Description: "The type of item the report belongs to.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entity.Type"),
+		},
+		{
+			Name:        "customer_id",
+			Description: "The unique identifier of the customer's account.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("Entity.CustomerId"),
+		},
+		{
+			Name:        "name",
+			Description: "The name of the parameter.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "int_value",
+			Description: "Integer value of the parameter. Null if the parameter has a value of another type.",
+			Type:        proto.ColumnType_INT,
+			Transform:   transform.FromField("IntValue"),
+		},
+		{
+			Name:        "bool_value",
+			Description: "Boolean value of the parameter. Null if the parameter has a value of another type.",
+			Type:        proto.ColumnType_BOOL,
+			Transform:   transform.FromField("BoolValue"),
+		},
+		{
+			Name:        "string_value",
+			Description: "String value of the parameter.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "datetime_value",
+			Description: "The RFC 3339 formatted value of the parameter.",
+			Type:        proto.ColumnType_TIMESTAMP,
+		},
+		{
+			Name:        "msg_value",
+			Description: "Nested message value of the parameter.",
+			Type:        proto.ColumnType_JSON,
+		},
+		{
+			Name:        "parameters",
+			Description: "A comma-separated list of event parameters to be returned, in the form `app_name:param_name`.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromQual("parameters"),
+		},
+	}
+}
+
+//// TABLE DEFINITION
+
+func tableGoogleworkspaceUsageReportUser(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "googleworkspace_usage_report_user",
+		Description: "Google Workspace user usage report parameters.",
+		List: &plugin.ListConfig{
+			Hydrate: listGoogleworkspaceUsageReportUsers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "date", Require: plugin.Required},
+				{Name: "user_key", Require: plugin.Optional},
+				{Name: "org_unit_id", Require: plugin.Optional},
+				{Name: "parameters", Require: plugin.Optional},
+				{Name: "filters", Require: plugin.Optional},
+			},
+			Tags: map[string]string{"service": "admin", "product": "reports", "action": "userUsageReport.get"},
+		},
+		Columns: append(
+			usageReportParameterColumns(),
+			&plugin.Column{
+				Name:        "user_email",
+				Description: "The user's email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entity.UserEmail"),
+			},
+			&plugin.Column{
+				Name:        "profile_id",
+				Description: "The user's immutable Google Workspace profile identifier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entity.ProfileId"),
+			},
+			&plugin.Column{
+				Name:        "user_key",
+				Description: "Represents the profile ID or the user email for which the data should be filtered. Defaults to `all`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("user_key"),
+			},
+			&plugin.Column{
+				Name:        "org_unit_id",
+				Description: "ID of the organizational unit to report on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("org_unit_id"),
+			},
+			&plugin.Column{
+				Name:        "filters",
+				Description: "A comma-separated list of filters on parameter values, for example `accounts:last_login_time>2010-10-28T10:26:35.000Z`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filters"),
+			},
+		),
+	}
+}
+
+//// LIST FUNCTION
+
+func listGoogleworkspaceUsageReportUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// https://developers.google.com/workspace/admin/reports/reference/rest/v1/userUsageReport/get#authorization-scopes
+	service, err := ReportsServiceWithScope(ctx, d, admin.AdminReportsUsageReadonlyScope)
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_usage_report_user.listGoogleworkspaceUsageReportUsers", "service_error", err)
+		return nil, err
+	}
+
+	date := d.EqualsQualString("date")
+
+	// Determine userKey: default to "all", or use the user_key if provided
+	userKey := "all"
+	if uk := d.EqualsQualString("user_key"); uk != "" {
+		userKey = uk
+	}
+
+	// Setting the maximum number of results, API can return in a single page
+	maxResults := int64(1000)
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < maxResults {
+			maxResults = *limit
+		}
+	}
+
+	resp := service.UserUsageReport.Get(userKey, date).MaxResults(maxResults)
+
+	if orgUnitID := d.EqualsQualString("org_unit_id"); orgUnitID != "" {
+		resp = resp.OrgUnitID(orgUnitID)
+	}
+
+	if parameters := d.EqualsQualString("parameters"); parameters != "" {
+		resp = resp.Parameters(parameters)
+	}
+
+	if filters := d.EqualsQualString("filters"); filters != "" {
+		resp = resp.Filters(filters)
+	}
+
+	err = resp.Pages(ctx, func(page *admin.UsageReports) error {
+		// rate limit
+		d.WaitForListRateLimit(ctx)
+
+		for _, report := range page.UsageReports {
+			for _, row := range flattenUsageReport(report) {
+				d.StreamListItem(ctx, row)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("googleworkspace_usage_report_user.listGoogleworkspaceUsageReportUsers", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// flattenUsageReport returns one row per parameter of the given usage report
+func flattenUsageReport(report *admin.UsageReport) []usageReportParameter {
+	var rows []usageReportParameter
+	for _, param := range report.Parameters {
+		if param == nil {
+			continue
+		}
+		row := usageReportParameter{
+			Date:          report.Date,
+			Entity:        report.Entity,
+			Name:          param.Name,
+			StringValue:   param.StringValue,
+			DatetimeValue: param.DatetimeValue,
+			MsgValue:      param.MsgValue,
+		}
+
+		// The API client drops the zero values, so a parameter without any value is either 0 or false
+		switch {
+		case param.IntValue != 0:
+			row.IntValue = &param.IntValue
+		case param.BoolValue:
+			row.BoolValue = &param.BoolValue
+		case param.StringValue == "" && param.DatetimeValue == "" && len(param.MsgValue) == 0:
+			row.IntValue = &param.IntValue
+			row.BoolValue = &param.BoolValue
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}